@@ -0,0 +1,147 @@
+package builder
+
+import (
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+const (
+	// lettersInOctave is amount of natural notes (letters) in octave including the letter of the octave above the first note.
+	lettersInOctave = 8
+	// spellingCostAltered is the cost of an augmented or diminished interval from the first note,
+	// it is paid for each halftone of alteration of the perfect or major/minor interval.
+	spellingCostAltered = 2
+	// spellingCostRepeatedLetter is the cost of a note with the same letter as the previous one, e.g. Eb and E.
+	spellingCostRepeatedLetter = 1
+	// spellingCostDoubleAccidental is the cost of each halftone of accidental beyond the single sharp or flat.
+	// It outweighs the cost of an altered interval together with a repeated letter, so the augmented scale from Gb is Gb, A, Bb, Db, D, F
+	// rather than Gb, Bbb, Bb, Db, Ebb, F.
+	spellingCostDoubleAccidental = spellingCostAltered + spellingCostRepeatedLetter + 1
+	// spellingCostImpossible is the cost of the spelling that requires more than double accidentals.
+	spellingCostImpossible = 1 << 16
+)
+
+// NewBuilderNonHeptatonic builds notes for the mode with any amount of degrees.
+// Notes are spelled by intervals from the first note: double sharps and flats are avoided, perfect, major and minor intervals
+// are preferred to augmented and diminished ones, and letters are not repeated when possible. So C minor pentatonic is C, Eb, F, G, Bb,
+// the augmented scale from C is C, Eb, E, G, Ab, B and the bebop dominant scale from C is C, D, E, F, G, A, Bb, B.
+// When letters can't be chosen equally well, the lower ones are preferred, so the whole tone scale from C is C, D, E, F#, Ab, Bb.
+func NewBuilderNonHeptatonic(modeTemplate HalftonesIterator, firstNote *note.Note) Builder {
+	send := func(n *note.Note, halfTones halftone.HalfTones) func() (*note.Note, halftone.HalfTones) {
+		return func() (*note.Note, halftone.HalfTones) { return n, halfTones }
+	}
+
+	f := func(c chan func() (*note.Note, halftone.HalfTones)) {
+		// The first note is the unison with itself
+		halfTonesFromPrime := []halftone.HalfTones{0}
+		for iteratorResult := range modeTemplate.Iterate() {
+			_, fromPrime := iteratorResult()
+
+			// To avoid duplicating root notes for one-note modes
+			if fromPrime >= halftone.HalfTonesInOctave {
+				break
+			}
+
+			halfTonesFromPrime = append(halfTonesFromPrime, fromPrime)
+		}
+
+		letters, ok := chooseLetters(firstNote, halfTonesFromPrime)
+		if !ok {
+			panic(errInvalidFirstTemplateNote)
+		}
+
+		for i := 1; i < len(halfTonesFromPrime); i++ {
+			// Notes are built without octaves as other builders do
+			n, _ := firstNote.TransposeBySpelling(int(halfTonesFromPrime[i]), letters[i])
+			c <- send(n.SetOctave(nil), halfTonesFromPrime[i])
+		}
+
+		close(c)
+	}
+
+	c := make(chan func() (*note.Note, halftone.HalfTones))
+	go f(c)
+
+	return c
+}
+
+// chooseLetters returns the amount of letters from the first note to each note of the mode, so that the total cost of the spelling is minimal.
+// Among the spellings with the same cost the one with the lower letters of the first notes is chosen.
+func chooseLetters(firstNote *note.Note, halfTonesFromPrime []halftone.HalfTones) ([]int, bool) {
+	degrees := len(halfTonesFromPrime)
+
+	// costs[i][l] is the minimal cost of spelling the notes from i to the last one if the note i is spelled with the letter l
+	costs := make([][lettersInOctave]int, degrees)
+	for i := degrees - 1; i >= 0; i-- {
+		for letter := range lettersInOctave {
+			costs[i][letter] = spellingCost(firstNote, halfTonesFromPrime[i], letter)
+			if i == degrees-1 {
+				continue
+			}
+
+			next := spellingCostImpossible
+			for nextLetter := letter; nextLetter < lettersInOctave; nextLetter++ {
+				next = min(next, costs[i+1][nextLetter]+repeatedLetterCost(letter, nextLetter))
+			}
+			costs[i][letter] += next
+		}
+	}
+
+	if costs[0][0] >= spellingCostImpossible {
+		return nil, false
+	}
+
+	letters := make([]int, degrees)
+	for i := 1; i < degrees; i++ {
+		best := letters[i-1]
+		for letter := letters[i-1]; letter < lettersInOctave; letter++ {
+			if costs[i][letter]+repeatedLetterCost(letters[i-1], letter) < costs[i][best]+repeatedLetterCost(letters[i-1], best) {
+				best = letter
+			}
+		}
+		letters[i] = best
+	}
+
+	return letters, true
+}
+
+// repeatedLetterCost returns the cost of spelling two neighbouring notes with the given letters.
+func repeatedLetterCost(letter, nextLetter int) int {
+	if letter == nextLetter {
+		return spellingCostRepeatedLetter
+	}
+
+	return 0
+}
+
+// spellingCost returns the cost of spelling the note the given amount of halftones and letters away from the first note.
+func spellingCost(firstNote *note.Note, halfTones halftone.HalfTones, letters int) int {
+	n, err := firstNote.TransposeBySpelling(int(halfTones), letters)
+	if err != nil {
+		return spellingCostImpossible
+	}
+
+	cost := 0
+	if alteration := int(n.GetAlterationShift()); alteration > 1 || alteration < -1 {
+		cost = (max(alteration, -alteration) - 1) * spellingCostDoubleAccidental
+	}
+
+	// Halftones from the first note to the notes of the major scale built from it
+	major := [lettersInOctave]int{0, 2, 4, 5, 7, 9, 11, 12}
+	diff := int(halfTones) - major[letters]
+
+	switch {
+	// Unison, fourth, fifth and octave are perfect
+	case letters == 0 || letters == 3 || letters == 4 || letters == 7: //nolint:mnd
+		if diff < 0 {
+			diff = -diff
+		}
+	// Other intervals may be major or minor
+	case diff < -1:
+		diff = -diff - 1
+	case diff < 0:
+		diff = 0
+	}
+
+	return cost + diff*spellingCostAltered
+}
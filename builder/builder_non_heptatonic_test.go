@@ -0,0 +1,104 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+func TestBuildNonHeptatonicMode(t *testing.T) {
+	testCases := []struct {
+		name          string
+		modeTemplate  halftone.Template
+		firstNote     note.Name
+		expectedNotes note.Names
+	}{
+		// Pentatonics
+		{"PentatonicMinor", halftone.Template{3, 2, 2, 3, 2}, note.C, note.Names{note.C, note.EFLAT, note.F, note.G, note.BFLAT}},
+		{"PentatonicMinor", halftone.Template{3, 2, 2, 3, 2}, note.A, note.Names{note.A, note.C, note.D, note.E, note.G}},
+		{"PentatonicMajor", halftone.Template{2, 2, 3, 2, 3}, note.FSHARP, note.Names{note.FSHARP, note.GSHARP, note.ASHARP, note.CSHARP, note.DSHARP}},
+		{"PentatonicHirajoshi", halftone.Template{2, 1, 4, 1, 4}, note.C, note.Names{note.C, note.D, note.EFLAT, note.G, note.AFLAT}},
+		{"PentatonicIwato", halftone.Template{1, 4, 1, 4, 2}, note.D, note.Names{note.D, note.EFLAT, note.G, note.AFLAT, note.C}},
+		{"PentatonicIwato from C#", halftone.Template{1, 4, 1, 4, 2}, note.CSHARP, note.Names{note.CSHARP, note.D, note.FSHARP, note.G, note.B}},
+		{"PentatonicIwato from Gb", halftone.Template{1, 4, 1, 4, 2}, note.GFLAT, note.Names{note.GFLAT, note.G, note.CFLAT, note.C, note.FFLAT}},
+		{"PentatonicHirajoshi from Gb", halftone.Template{2, 1, 4, 1, 4}, note.GFLAT, note.Names{note.GFLAT, note.AFLAT, note.A, note.DFLAT, note.D}},
+		{"PentatonicHonKumoiJoshi from Db", halftone.Template{1, 4, 2, 1, 4}, note.DFLAT, note.Names{note.DFLAT, note.D, note.GFLAT, note.AFLAT, note.A}},
+
+		// Hexatonic modes
+		{"WholeTone", halftone.Template{2, 2, 2, 2, 2, 2}, note.C, note.Names{note.C, note.D, note.E, note.FSHARP, note.AFLAT, note.BFLAT}},
+		{"Augmented", halftone.Template{3, 1, 3, 1, 3, 1}, note.C, note.Names{note.C, note.EFLAT, note.E, note.G, note.AFLAT, note.B}},
+		{"Augmented from Gb", halftone.Template{3, 1, 3, 1, 3, 1}, note.GFLAT, note.Names{note.GFLAT, note.A, note.BFLAT, note.DFLAT, note.D, note.F}},
+		{"Augmented from Db", halftone.Template{3, 1, 3, 1, 3, 1}, note.DFLAT, note.Names{note.DFLAT, note.FFLAT, note.F, note.AFLAT, note.A, note.C}},
+		{"Augmented from C#", halftone.Template{3, 1, 3, 1, 3, 1}, note.CSHARP, note.Names{note.CSHARP, note.E, note.ESHARP, note.GSHARP, note.A, note.BSHARP}},
+		{"BluesHexatonic", halftone.Template{3, 2, 1, 1, 3, 2}, note.A, note.Names{note.A, note.C, note.D, note.DSHARP, note.E, note.G}},
+		{"BluesHexatonic from C#", halftone.Template{3, 2, 1, 1, 3, 2}, note.CSHARP, note.Names{note.CSHARP, note.E, note.FSHARP, note.G, note.GSHARP, note.B}},
+		{"BluesHexatonic from Gb", halftone.Template{3, 2, 1, 1, 3, 2}, note.GFLAT, note.Names{note.GFLAT, note.A, note.CFLAT, note.C, note.DFLAT, note.FFLAT}},
+
+		// Octatonic modes
+		{"DiminishedHalfWhole", halftone.Template{1, 2, 1, 2, 1, 2, 1, 2}, note.C, note.Names{note.C, note.DFLAT, note.EFLAT, note.E, note.FSHARP, note.G, note.A, note.BFLAT}},
+		{"DiminishedWholeHalf", halftone.Template{2, 1, 2, 1, 2, 1, 2, 1}, note.C, note.Names{note.C, note.D, note.EFLAT, note.F, note.GFLAT, note.AFLAT, note.A, note.B}},
+		{"BebopDominant", halftone.Template{2, 2, 1, 2, 2, 1, 1, 1}, note.G, note.Names{note.G, note.A, note.B, note.C, note.D, note.E, note.F, note.FSHARP}},
+		{"BebopMajor", halftone.Template{2, 2, 1, 2, 1, 1, 2, 1}, note.EFLAT, note.Names{note.EFLAT, note.F, note.G, note.AFLAT, note.BFLAT, note.CFLAT, note.C, note.D}},
+
+		// Modes with other amount of degrees
+		{"custom mode with 1 degree", halftone.Template{12}, note.C, note.Names{note.C}},
+		{"custom mode with 4 degrees", halftone.Template{3, 3, 3, 3}, note.C, note.Names{note.C, note.EFLAT, note.FSHARP, note.A}},
+		{"chromatic", halftone.Template{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, note.C, note.Names{
+			note.C, note.DFLAT, note.D, note.EFLAT, note.E, note.F, note.FSHARP, note.G, note.AFLAT, note.A, note.BFLAT, note.B,
+		}},
+		{"chromatic from double sharp", halftone.Template{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, note.DSHARP2, note.Names{
+			note.DSHARP2, note.ESHARP, note.FSHARP, note.FSHARP2, note.GSHARP, note.GSHARP2, note.ASHARP, note.B, note.BSHARP, note.CSHARP, note.CSHARP2, note.DSHARP,
+		}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.modeTemplate.Length(), testCase.expectedNotes.Length())
+
+			builder := NewBuilderNonHeptatonic(testCase.modeTemplate, note.MustNewNote(testCase.firstNote))
+			notes := note.Names{testCase.firstNote}
+			var halfTonesFromPrime halftone.HalfTones
+			for res := range builder {
+				n, fromPrime := res()
+				halfTonesFromPrime += testCase.modeTemplate[len(notes)-1]
+				assert.Equal(t, halfTonesFromPrime, fromPrime)
+				notes = append(notes, n.Name())
+			}
+
+			assert.Equal(t, testCase.expectedNotes, notes)
+		})
+	}
+}
+
+func TestBuildNonHeptatonicModeWithoutDoubleAccidentals(t *testing.T) {
+	templates := []halftone.Template{
+		{3, 1, 3, 1, 3, 1}, {3, 2, 1, 1, 3, 2}, {2, 2, 2, 2, 2, 2}, {1, 4, 2, 1, 4}, {1, 4, 1, 4, 2}, {2, 1, 4, 1, 4}, {4, 1, 4, 2, 1},
+	}
+	firstNotes := note.Names{
+		note.C, note.CSHARP, note.DFLAT, note.D, note.DSHARP, note.EFLAT, note.E, note.F, note.FSHARP,
+		note.GFLAT, note.G, note.GSHARP, note.AFLAT, note.A, note.ASHARP, note.BFLAT, note.B,
+	}
+
+	for _, modeTemplate := range templates {
+		for _, firstNote := range firstNotes {
+			for res := range NewBuilderNonHeptatonic(modeTemplate, note.MustNewNote(firstNote)) {
+				n, _ := res()
+				alteration := n.GetAlterationShift()
+				assert.True(t, alteration >= -1 && alteration <= 1, "template: %v, first note: %s, note: %s", modeTemplate, firstNote, n.Name())
+			}
+		}
+	}
+}
+
+func TestBuildNonHeptatonicModeOctaves(t *testing.T) {
+	firstNote := note.MustNewNoteWithOctave(note.A, 4)
+	for res := range NewBuilderNonHeptatonic(halftone.Template{3, 2, 2, 3, 2}, firstNote) {
+		n, _ := res()
+		assert.Nil(t, n.Octave())
+	}
+	assert.NotNil(t, firstNote.Octave())
+}
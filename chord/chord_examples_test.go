@@ -6,7 +6,9 @@ import (
 
 	"github.com/go-muse/muse/chord"
 	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/interval"
 	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
 )
 
 // Creating a new chord.
@@ -118,3 +120,61 @@ func ExampleChord_SetDuration() {
 	// note: B custom duration: 1s
 	// note: D custom duration: 1s
 }
+
+// Dissonance of a chord can be graded by Hindemith's series of sonances or by roughness of its sound.
+func ExampleChord_Dissonance() {
+	majorTriad := chord.NewChord(
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+	)
+
+	hindemith, err := majorTriad.Dissonance(interval.HindemithModel())
+	if err != nil {
+		panic(err)
+	}
+
+	roughness, err := majorTriad.Dissonance(interval.PlompLeveltModel(440, 6))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.2f %.2f\n", hindemith, roughness)
+	// Output: 1.17 1.07
+}
+
+// Inversions and voicings are new chords sorted by pitch, the notes of the chord are the chord tones from the root.
+func ExampleChord_Voice() {
+	dm7 := chord.NewChord(
+		note.MustNewNoteWithOctave(note.D, octave.Number4),
+		note.MustNewNoteWithOctave(note.F, octave.Number4),
+		note.MustNewNoteWithOctave(note.A, octave.Number4),
+		note.MustNewNoteWithOctave(note.C, octave.Number5),
+	)
+
+	inversion, err := dm7.Inversion(1, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(inversion.Notes())
+
+	drop2, err := dm7.Voice(chord.VoicingDrop2, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(drop2.Notes())
+
+	register := &chord.Register{Low: note.MustNewNoteWithOctave(note.C, octave.Number3), High: note.MustNewNoteWithOctave(note.C, octave.Number5)}
+	rootless, err := dm7.Voice(chord.VoicingRootless, register)
+	if err != nil {
+		panic(err)
+	}
+	for _, n := range rootless.Notes() {
+		fmt.Println(n.Name(), n.Octave().Number())
+	}
+	// Output: [F A C D]
+	// [A D F C]
+	// F 3
+	// A 3
+	// C 4
+}
@@ -0,0 +1,15 @@
+package chord
+
+import (
+	"github.com/go-muse/muse/interval"
+)
+
+// Dissonance returns the numeric dissonance of the chord as the sum of dissonances of all the pairs of its notes
+// calculated by the given model, e.g. interval.HindemithModel() or interval.PlompLeveltModel(440, 6).
+func (c *Chord) Dissonance(model interval.DissonanceModel) (float64, error) {
+	if c == nil {
+		return 0, nil
+	}
+
+	return interval.NotesDissonance(c.notes, model)
+}
@@ -0,0 +1,40 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestChord_Dissonance(t *testing.T) {
+	majorTriad := NewChord(
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+	)
+	diminishedTriad := NewChord(
+		note.MustNewNoteWithOctave(note.B, octave.Number3),
+		note.MustNewNoteWithOctave(note.D, octave.Number4),
+		note.MustNewNoteWithOctave(note.F, octave.Number4),
+	)
+
+	for _, model := range []interval.DissonanceModel{interval.HindemithModel(), interval.PlompLeveltModel(440, 6)} {
+		majorDissonance, err := majorTriad.Dissonance(model)
+		require.NoError(t, err)
+		diminishedDissonance, err := diminishedTriad.Dissonance(model)
+		require.NoError(t, err)
+		assert.Greater(t, diminishedDissonance, majorDissonance)
+	}
+
+	dissonance, err := (*Chord)(nil).Dissonance(interval.HindemithModel())
+	require.NoError(t, err)
+	assert.Zero(t, dissonance)
+
+	_, err = NewChord(note.MustNewNote(note.C), note.MustNewNote(note.E)).Dissonance(interval.PlompLeveltModel(440, 6))
+	require.ErrorIs(t, err, interval.ErrFrequencyUnknown)
+}
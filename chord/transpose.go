@@ -0,0 +1,42 @@
+package chord
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+)
+
+// Transpose returns a new chord with the notes moved by the interval in the given direction.
+// The spelling of the notes is kept, the duration of the chord is copied.
+func (c *Chord) Transpose(ic *interval.Chromatic, direction interval.Direction) (*Chord, error) {
+	if c == nil {
+		return nil, nil //nolint:nilnil // nil chord is transposed to nil chord
+	}
+
+	notes, err := interval.TransposeNotes(c.notes, ic, direction)
+	if err != nil {
+		return nil, fmt.Errorf("transpose chord: %w", err)
+	}
+
+	transposed := &Chord{duration: c.duration, value: c.value}
+
+	return transposed.AddNotes(notes...), nil
+}
+
+// TransposeToKey returns a new chord moved from the key with one tonic to the key with another one
+// with simplified spelling of the notes.
+func (c *Chord) TransposeToKey(fromTonic, toTonic *note.Note) (*Chord, error) {
+	if c == nil {
+		return nil, nil //nolint:nilnil // nil chord is transposed to nil chord
+	}
+
+	notes, err := interval.TransposeNotesToKey(c.notes, fromTonic, toTonic)
+	if err != nil {
+		return nil, fmt.Errorf("transpose chord to key: %w", err)
+	}
+
+	transposed := &Chord{duration: c.duration, value: c.value}
+
+	return transposed.AddNotes(notes...), nil
+}
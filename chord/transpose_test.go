@@ -0,0 +1,47 @@
+package chord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestChord_Transpose(t *testing.T) {
+	c := NewChord(
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+	).SetDuration(time.Second).SetValue(duration.NewRelative(duration.NameHalf))
+
+	transposed, err := c.Transpose(interval.MinorSixth(), interval.DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, note.Notes{
+		note.MustNewNoteWithOctave(note.AFLAT, octave.Number4).SetDuration(time.Second).SetValue(duration.NewRelative(duration.NameHalf)),
+		note.MustNewNoteWithOctave(note.C, octave.Number5).SetDuration(time.Second).SetValue(duration.NewRelative(duration.NameHalf)),
+		note.MustNewNoteWithOctave(note.EFLAT, octave.Number5).SetDuration(time.Second).SetValue(duration.NewRelative(duration.NameHalf)),
+	}, transposed.Notes())
+	assert.Equal(t, c.Duration(), transposed.Duration())
+	assert.Equal(t, c.Value(), transposed.Value())
+	assert.Equal(t, note.C, c.Notes()[0].Name())
+
+	transposed, err = c.TransposeToKey(note.MustNewNote(note.C), note.MustNewNote(note.GSHARP))
+	require.NoError(t, err)
+	assert.Equal(t, note.GSHARP, transposed.Notes()[0].Name())
+	assert.Equal(t, octave.Number3, transposed.Notes()[0].Octave().Number())
+	assert.Equal(t, note.C, transposed.Notes()[1].Name())
+	assert.Equal(t, note.DSHARP, transposed.Notes()[2].Name())
+
+	_, err = c.Transpose(nil, interval.DirectionAscending)
+	require.ErrorIs(t, err, interval.ErrIntervalUnknown)
+
+	transposed, err = (*Chord)(nil).Transpose(interval.MinorSixth(), interval.DirectionAscending)
+	require.NoError(t, err)
+	assert.Nil(t, transposed)
+}
@@ -0,0 +1,267 @@
+package chord
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+var (
+	// ErrVoicingInvalid is returned when the chord has not enough notes for the voicing or the octave of the voicing is unknown.
+	ErrVoicingInvalid = errors.New("invalid chord voicing")
+	// ErrOutOfRegister is returned when the voiced chord doesn't fit the register.
+	ErrOutOfRegister = errors.New("chord is out of register")
+)
+
+// Voicing is the way of arranging notes of the chord by octaves.
+type Voicing uint8
+
+const (
+	// VoicingClose stacks the notes from the root as close as possible: C4 E4 G4 B4.
+	VoicingClose Voicing = iota
+	// VoicingOpen raises every second note of the close voicing by an octave: C4 G4 E5 B5.
+	VoicingOpen
+	// VoicingDrop2 lowers the second note from the top of the close voicing by an octave: G3 C4 E4 B4.
+	VoicingDrop2
+	// VoicingDrop3 lowers the third note from the top of the close voicing by an octave: E3 C4 G4 B4.
+	VoicingDrop3
+	// VoicingDrop24 lowers the second and the fourth notes from the top of the close voicing by an octave: C3 G3 E4 B4.
+	VoicingDrop24
+	// VoicingSpread keeps the root an octave below the other notes in close voicing: C3 E4 G4 B4.
+	VoicingSpread
+	// VoicingRootless is the close voicing without the root starting from the third: E4 G4 B4.
+	VoicingRootless
+)
+
+// Register is the range of pitches for the voiced chord including its bounds. The bounds must have octaves.
+type Register struct {
+	Low, High *note.Note
+}
+
+// tones returns names of the chord tones in insertion order without repetitions.
+func (c *Chord) tones() note.Names {
+	names := make(note.Names, 0, len(c.Notes()))
+	seen := make(map[note.Name]struct{}, len(c.Notes()))
+	for _, n := range c.Notes() {
+		if _, ok := seen[n.Name()]; !ok {
+			seen[n.Name()] = struct{}{}
+			names = append(names, n.Name())
+		}
+	}
+
+	return names
+}
+
+// stackUp returns the notes with the given names, each one being the nearest note above the previous one, starting from the first note.
+func stackUp(first *note.Note, names note.Names) (note.Notes, error) {
+	notes := note.Notes{first}
+	for _, name := range names {
+		previous := notes[len(notes)-1]
+		for octaveNumber := previous.Octave().Number(); ; octaveNumber++ {
+			n, err := note.NewNoteWithOctave(name, octaveNumber)
+			if err != nil {
+				return nil, fmt.Errorf("stack note '%s' above '%s': %w: %w", name, previous.Name(), ErrOutOfRegister, err)
+			}
+
+			if n.Compare(previous) > 0 {
+				notes = append(notes, n)
+
+				break
+			}
+		}
+	}
+
+	return notes, nil
+}
+
+// shiftByOctaves moves the note by the given amount of octaves, negative amounts move it down.
+func shiftByOctaves(n *note.Note, octaves int) (*note.Note, error) {
+	const lettersInOctave = 7
+	shifted, err := n.TransposeBySpelling(octaves*int(octave.NotesInOctave), octaves*lettersInOctave)
+	if err != nil {
+		return nil, fmt.Errorf("shift note '%s' by %d octaves: %w: %w", n.Name(), octaves, ErrOutOfRegister, err)
+	}
+
+	return shifted, nil
+}
+
+// closeVoicing returns the notes of the chord stacked closely from the chord tone with the given index in the octave of the first note of the chord,
+// or in octave 4 if it has no octave and the chord is placed into the register later.
+func (c *Chord) closeVoicing(bassIndex int, register *Register) (note.Notes, error) {
+	tones := c.tones()
+	if bassIndex < 0 || bassIndex >= len(tones) {
+		return nil, fmt.Errorf("chord of %d notes with note %d in the bass: %w", len(tones), bassIndex+1, ErrVoicingInvalid)
+	}
+
+	octaveNumber := octave.Number4
+	switch {
+	case c.notes[0].Octave() != nil:
+		octaveNumber = c.notes[0].Octave().Number()
+	case register == nil:
+		return nil, fmt.Errorf("chord without octave and register: %w", ErrVoicingInvalid)
+	}
+
+	bass, err := note.NewNoteWithOctave(tones[bassIndex], octaveNumber)
+	if err != nil {
+		return nil, fmt.Errorf("bass note of the chord: %w: %w", ErrVoicingInvalid, err)
+	}
+
+	return stackUp(bass, append(tones[bassIndex+1:len(tones):len(tones)], tones[:bassIndex]...))
+}
+
+// newVoicedChord returns a new chord with the notes sorted by pitch and moved by octaves into the register if it's given.
+// The duration of the chord is copied.
+func (c *Chord) newVoicedChord(notes note.Notes, register *Register) (*Chord, error) {
+	sort.SliceStable(notes, func(i, j int) bool {
+		if notes[i].MIDINumber() != notes[j].MIDINumber() {
+			return notes[i].MIDINumber() < notes[j].MIDINumber()
+		}
+
+		return notes[i].Compare(notes[j]) < 0
+	})
+
+	if register != nil {
+		placed, err := placeIntoRegister(notes, register)
+		if err != nil {
+			return nil, err
+		}
+		notes = placed
+	}
+
+	voiced := &Chord{duration: c.duration, value: c.value}
+
+	return voiced.AddNotes(notes...), nil
+}
+
+// placeIntoRegister moves the notes sorted by pitch by octaves, so the lowest note is the lowest one above the low bound of the register.
+func placeIntoRegister(notes note.Notes, register *Register) (note.Notes, error) {
+	if register.Low == nil || register.High == nil || register.Low.Octave() == nil || register.High.Octave() == nil {
+		return nil, fmt.Errorf("register without octaves: %w", ErrOutOfRegister)
+	}
+
+	// The lowest note is tried in the octave below the low bound, in its octave and above it.
+	// Shifts out of the octave range are skipped, so the register may start in the lowest octave.
+	lowest := notes[0]
+	first := int(register.Low.Octave().Number()) - int(lowest.Octave().Number()) - 1
+	octaves, found := first, false
+	for ; octaves <= first+2; octaves++ {
+		if shifted, err := shiftByOctaves(lowest, octaves); err == nil && shifted.Compare(register.Low) >= 0 {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("note '%s' can't be moved above '%s': %w", lowest.Name(), register.Low.Name(), ErrOutOfRegister)
+	}
+
+	placed := make(note.Notes, 0, len(notes))
+	for _, n := range notes {
+		shifted, err := shiftByOctaves(n, octaves)
+		if err != nil {
+			return nil, err
+		}
+
+		if shifted.Compare(register.High) > 0 {
+			return nil, fmt.Errorf("note '%s' is above '%s': %w", shifted.Name(), register.High.Name(), ErrOutOfRegister)
+		}
+
+		placed = append(placed, shifted)
+	}
+
+	return placed, nil
+}
+
+// RootPosition returns a new chord in close voicing with the root in the bass. The notes of the chord in insertion order
+// are considered as the chord tones starting from the root, e.g. C E G Bb. The chord is built from the octave of the root
+// or placed into the register if it's given.
+func (c *Chord) RootPosition(register *Register) (*Chord, error) {
+	return c.Inversion(0, register)
+}
+
+// Inversion returns a new chord in close voicing with the given chord tone in the bass: the first inversion of C E G is E G C,
+// the second one is G C E. Zero inversion is the root position. The notes of the chord in insertion order are considered
+// as the chord tones starting from the root. The chord is built from the octave of the root or placed into the register if it's given.
+func (c *Chord) Inversion(inversion int, register *Register) (*Chord, error) {
+	if c == nil || len(c.notes) == 0 {
+		return nil, fmt.Errorf("inversion of empty chord: %w", ErrVoicingInvalid)
+	}
+
+	notes, err := c.closeVoicing(inversion, register)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newVoicedChord(notes, register)
+}
+
+// getMinimalTones returns amount of chord tones required by the voicings.
+func getMinimalTones() map[Voicing]int {
+	return map[Voicing]int{
+		VoicingClose:    1,
+		VoicingOpen:     3, //nolint:mnd
+		VoicingDrop2:    3, //nolint:mnd
+		VoicingDrop3:    3, //nolint:mnd
+		VoicingDrop24:   4, //nolint:mnd
+		VoicingSpread:   2, //nolint:mnd
+		VoicingRootless: 3, //nolint:mnd
+	}
+}
+
+// Voice returns a new chord with the notes arranged by the voicing, sorted by pitch.
+// The notes of the chord in insertion order are considered as the chord tones starting from the root.
+// Voicings are derived from the close voicing built from the octave of the root, so the dropped notes go below it.
+// If the register is given, the chord is moved by octaves to the lowest position within it.
+func (c *Chord) Voice(voicing Voicing, register *Register) (*Chord, error) {
+	if c == nil || len(c.notes) == 0 {
+		return nil, fmt.Errorf("voicing of empty chord: %w", ErrVoicingInvalid)
+	}
+
+	minimalTones, ok := getMinimalTones()[voicing]
+	if !ok || len(c.tones()) < minimalTones {
+		return nil, fmt.Errorf("voicing %d of chord with %d notes: %w", voicing, len(c.tones()), ErrVoicingInvalid)
+	}
+
+	notes, err := c.closeVoicing(0, register)
+	if err != nil {
+		return nil, err
+	}
+
+	// Octave shifts of the notes of the close voicing counted from the bottom
+	shifts := make([]int, len(notes))
+	top := len(notes) - 1
+	switch voicing {
+	case VoicingOpen:
+		for i := 1; i < len(notes); i += 2 {
+			shifts[i] = 1
+		}
+	case VoicingDrop2:
+		shifts[top-1] = -1
+	case VoicingDrop3:
+		shifts[top-2] = -1
+	case VoicingDrop24:
+		shifts[top-1], shifts[top-3] = -1, -1
+	case VoicingSpread:
+		shifts[0] = -1
+	case VoicingRootless:
+		notes = notes[1:]
+		shifts = shifts[1:]
+	case VoicingClose:
+	}
+
+	voiced := make(note.Notes, 0, len(notes))
+	for i, n := range notes {
+		shifted, err := shiftByOctaves(n, shifts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		voiced = append(voiced, shifted)
+	}
+
+	return c.newVoicedChord(voiced, register)
+}
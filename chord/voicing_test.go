@@ -0,0 +1,192 @@
+package chord
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/note"
+)
+
+// namesWithOctaves returns names of the notes of the chord with their octaves separated by spaces, e.g. "C4 E4 G4".
+func namesWithOctaves(c *Chord) string {
+	names := make([]string, 0, len(c.Notes()))
+	for _, n := range c.Notes() {
+		names = append(names, fmt.Sprintf("%s%d", n.Name(), n.Octave().Number()))
+	}
+
+	return strings.Join(names, " ")
+}
+
+func cMajor7() *Chord {
+	return NewChord(
+		note.MustNewNoteWithOctave(note.C, 4),
+		note.MustNewNoteWithOctave(note.E, 4),
+		note.MustNewNoteWithOctave(note.G, 4),
+		note.MustNewNoteWithOctave(note.B, 4),
+	)
+}
+
+func TestChord_Inversion(t *testing.T) {
+	testCases := []struct {
+		chord     *Chord
+		inversion int
+		register  *Register
+		expected  string
+	}{
+		{chord: cMajor7(), inversion: 0, expected: "C4 E4 G4 B4"},
+		{chord: cMajor7(), inversion: 1, expected: "E4 G4 B4 C5"},
+		{chord: cMajor7(), inversion: 2, expected: "G4 B4 C5 E5"},
+		{chord: cMajor7(), inversion: 3, expected: "B4 C5 E5 G5"},
+		{
+			chord:     NewChord(note.MustNewNote(note.FSHARP), note.MustNewNote(note.ASHARP), note.MustNewNote(note.CSHARP)),
+			inversion: 2,
+			register:  &Register{Low: note.MustNewNoteWithOctave(note.A, 2), High: note.MustNewNoteWithOctave(note.C, 5)},
+			expected:  "C#3 F#3 A#3",
+		},
+		{
+			chord:     NewChord(note.MustNewNoteWithOctave(note.A, 5), note.MustNewNoteWithOctave(note.C, 6), note.MustNewNoteWithOctave(note.E, 6)),
+			inversion: 1,
+			register:  &Register{Low: note.MustNewNoteWithOctave(note.C, 4), High: note.MustNewNoteWithOctave(note.C, 5)},
+			expected:  "C4 E4 A4",
+		},
+		{
+			chord:     NewChord(note.MustNewNoteWithOctave(note.G, 3), note.MustNewNoteWithOctave(note.B, 3), note.MustNewNoteWithOctave(note.D, 4), note.MustNewNoteWithOctave(note.F, 4)),
+			inversion: 3,
+			expected:  "F3 G3 B3 D4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		inverted, err := testCase.chord.Inversion(testCase.inversion, testCase.register)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, namesWithOctaves(inverted), "chord: %s, inversion: %d", testCase.chord.Notes(), testCase.inversion)
+	}
+
+	t.Run("Inversion: root position and the chord is not changed", func(t *testing.T) {
+		c := NewChord(note.MustNewNoteWithOctave(note.G, 4), note.MustNewNoteWithOctave(note.C, 4), note.MustNewNoteWithOctave(note.E, 4))
+		c.SetValue(duration.NewRelative(duration.NameHalf))
+		rootPosition, err := c.RootPosition(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "G4 C5 E5", namesWithOctaves(rootPosition))
+		assert.Equal(t, "G4 C4 E4", namesWithOctaves(c))
+		assert.Equal(t, c.Value(), rootPosition.Value())
+		assert.Equal(t, c.Value(), rootPosition.Notes()[0].Value())
+	})
+
+	t.Run("Inversion: invalid cases", func(t *testing.T) {
+		_, err := cMajor7().Inversion(4, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		_, err = NewChordEmpty().Inversion(0, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		var c *Chord
+		_, err = c.Inversion(0, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		_, err = NewChord(note.MustNewNote(note.C), note.MustNewNote(note.E)).Inversion(0, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		register := &Register{Low: note.MustNewNoteWithOctave(note.C, 4), High: note.MustNewNoteWithOctave(note.A, 4)}
+		_, err = cMajor7().Inversion(0, register)
+		require.ErrorIs(t, err, ErrOutOfRegister)
+
+		_, err = cMajor7().Inversion(0, &Register{Low: note.MustNewNote(note.C)})
+		require.ErrorIs(t, err, ErrOutOfRegister)
+	})
+}
+
+func TestChord_Voice(t *testing.T) {
+	testCases := []struct {
+		chord    *Chord
+		voicing  Voicing
+		register *Register
+		expected string
+	}{
+		{chord: cMajor7(), voicing: VoicingClose, expected: "C4 E4 G4 B4"},
+		{chord: cMajor7(), voicing: VoicingOpen, expected: "C4 G4 E5 B5"},
+		{chord: cMajor7(), voicing: VoicingDrop2, expected: "G3 C4 E4 B4"},
+		{chord: cMajor7(), voicing: VoicingDrop3, expected: "E3 C4 G4 B4"},
+		{chord: cMajor7(), voicing: VoicingDrop24, expected: "C3 G3 E4 B4"},
+		{chord: cMajor7(), voicing: VoicingSpread, expected: "C3 E4 G4 B4"},
+		{chord: cMajor7(), voicing: VoicingRootless, expected: "E4 G4 B4"},
+		{
+			chord:   NewChord(note.MustNewNoteWithOctave(note.C, 4), note.MustNewNoteWithOctave(note.E, 4), note.MustNewNoteWithOctave(note.G, 4)),
+			voicing: VoicingOpen, expected: "C4 G4 E5",
+		},
+		{
+			chord:    cMajor7(),
+			voicing:  VoicingDrop2,
+			register: &Register{Low: note.MustNewNoteWithOctave(note.E, 3), High: note.MustNewNoteWithOctave(note.C, 5)},
+			expected: "G3 C4 E4 B4",
+		},
+		{
+			chord: NewChord(
+				note.MustNewNote(note.D), note.MustNewNote(note.F), note.MustNewNote(note.A), note.MustNewNote(note.C), note.MustNewNote(note.E),
+			),
+			voicing:  VoicingRootless,
+			register: &Register{Low: note.MustNewNoteWithOctave(note.D, 3), High: note.MustNewNoteWithOctave(note.C, 5)},
+			expected: "F3 A3 C4 E4",
+		},
+		{
+			chord: NewChord(
+				note.MustNewNoteWithOctave(note.BFLAT, 3), note.MustNewNoteWithOctave(note.D, 4), note.MustNewNoteWithOctave(note.F, 4), note.MustNewNoteWithOctave(note.AFLAT, 4),
+			),
+			voicing:  VoicingDrop24,
+			register: &Register{Low: note.MustNewNoteWithOctave(note.E, 2), High: note.MustNewNoteWithOctave(note.A, 4)},
+			expected: "Bb2 F3 D4 Ab4",
+		},
+		{
+			chord:    NewChord(note.MustNewNoteWithOctave(note.C, 4), note.MustNewNoteWithOctave(note.E, 4), note.MustNewNoteWithOctave(note.G, 4)),
+			voicing:  VoicingClose,
+			register: &Register{Low: note.MustNewNoteWithOctave(note.A, -1), High: note.MustNewNoteWithOctave(note.C, 9)},
+			expected: "C0 E0 G0",
+		},
+		{
+			chord:    NewChord(note.MustNewNoteWithOctave(note.C, 4), note.MustNewNoteWithOctave(note.E, 4), note.MustNewNoteWithOctave(note.G, 4)),
+			voicing:  VoicingClose,
+			register: &Register{Low: note.MustNewNoteWithOctave(note.C, -1), High: note.MustNewNoteWithOctave(note.C, 9)},
+			expected: "C-1 E-1 G-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		voiced, err := testCase.chord.Voice(testCase.voicing, testCase.register)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, namesWithOctaves(voiced), "chord: %s, voicing: %d", testCase.chord.Notes(), testCase.voicing)
+
+		for i := 1; i < len(voiced.Notes()); i++ {
+			assert.Less(t, voiced.Notes()[i-1].MIDINumber(), voiced.Notes()[i].MIDINumber())
+		}
+	}
+
+	t.Run("Voice: not enough notes for the voicing", func(t *testing.T) {
+		triad := NewChord(note.MustNewNoteWithOctave(note.C, 4), note.MustNewNoteWithOctave(note.E, 4), note.MustNewNoteWithOctave(note.G, 4))
+		_, err := triad.Voice(VoicingDrop24, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		_, err = NewChord(note.MustNewNoteWithOctave(note.C, 4)).Voice(VoicingRootless, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		_, err = triad.Voice(Voicing(100), nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+
+		_, err = NewChordEmpty().Voice(VoicingClose, nil)
+		require.ErrorIs(t, err, ErrVoicingInvalid)
+	})
+
+	t.Run("Voice: out of register", func(t *testing.T) {
+		register := &Register{Low: note.MustNewNoteWithOctave(note.C, 4), High: note.MustNewNoteWithOctave(note.C, 5)}
+		_, err := cMajor7().Voice(VoicingOpen, register)
+		require.ErrorIs(t, err, ErrOutOfRegister)
+
+		register = &Register{Low: note.MustNewNoteWithOctave(note.D, 9), High: note.MustNewNoteWithOctave(note.B, 9)}
+		_, err = cMajor7().Voice(VoicingClose, register)
+		require.ErrorIs(t, err, ErrOutOfRegister)
+	})
+}
@@ -0,0 +1,85 @@
+// Package exercise generates melodic exercises on scales: sequences in intervals, groups of notes,
+// broken chords and enclosures with the given rhythm.
+package exercise
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/scale"
+	"github.com/go-muse/muse/track"
+)
+
+// ErrScaleInvalid is returned when the notes of the scale have no octaves or don't go up.
+var ErrScaleInvalid = errors.New("invalid scale for exercise")
+
+// Options are the settings of the exercise.
+type Options struct {
+	Pattern   Pattern
+	Direction mode.ScaleDirection
+	// Rhythm is the relative durations repeated over the notes of the exercise. Notes have no durations if it's empty.
+	Rhythm []*duration.Relative
+}
+
+// Generate applies the pattern to each note of the scale and returns the notes of all the groups.
+// The notes of the scale must have octaves and go up, the groups never go out of the scale, so the range of the exercise is the range of the scale.
+// Descending exercises start from the highest note of the scale and apply the pattern downwards.
+func Generate(s scale.Scale, opts Options) (note.Notes, error) {
+	if opts.Pattern == nil || len(s) == 0 {
+		return nil, fmt.Errorf("generate exercise of %d notes: %w", len(s), ErrScaleInvalid)
+	}
+
+	for i, n := range s {
+		if n == nil || n.Octave() == nil || (i > 0 && s[i-1].Compare(n) >= 0) {
+			return nil, fmt.Errorf("note %d of scale %s: %w", i+1, s, ErrScaleInvalid)
+		}
+	}
+
+	notes := make(note.Notes, 0)
+	if opts.Direction != mode.ScaleDescending {
+		for i := range s {
+			if group, ok := opts.Pattern(s, i, false); ok {
+				notes = append(notes, group...)
+			}
+		}
+	}
+
+	if opts.Direction != mode.ScaleAscending {
+		for i := len(s) - 1; i >= 0; i-- {
+			if group, ok := opts.Pattern(s, i, true); ok {
+				notes = append(notes, group...)
+			}
+		}
+	}
+
+	if len(opts.Rhythm) > 0 {
+		for i, n := range notes {
+			n.SetValue(opts.Rhythm[i%len(opts.Rhythm)])
+		}
+	}
+
+	return notes, nil
+}
+
+// GenerateFromMode applies the pattern to the scale of the mode of the given amount of octaves from the start note with octave.
+func GenerateFromMode(m *mode.Mode, start *note.Note, octaves uint8, opts Options) (note.Notes, error) {
+	s, err := m.GenerateScaleOctaves(start, octaves, mode.ScaleAscending)
+	if err != nil {
+		return nil, fmt.Errorf("generate exercise in mode '%s': %w", m.Name(), err)
+	}
+
+	return Generate(s, opts)
+}
+
+// NewTrack creates the track with the notes of the exercise played one after another by their relative durations.
+func NewTrack(notes note.Notes, settings *track.Settings) *track.Track {
+	t := track.NewTrack(settings)
+	for _, n := range notes {
+		t.AddNoteToTheEnd(n, false)
+	}
+
+	return t
+}
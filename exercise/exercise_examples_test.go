@@ -0,0 +1,31 @@
+package exercise_test
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/exercise"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+)
+
+// Sequence in thirds on the G major scale with the dotted rhythm of eighths and sixteenths.
+func ExampleGenerateFromMode() {
+	gMajor := mode.MustMakeNewMode(mode.NameNaturalMajor, note.G)
+	notes, err := exercise.GenerateFromMode(gMajor, note.MustNewNoteWithOctave(note.G, 3), 1, exercise.Options{
+		Pattern:   exercise.Intervals(exercise.StepsThird),
+		Direction: mode.ScaleAscending,
+		Rhythm:    []*duration.Relative{duration.NewRelative(duration.NameEighth).AddDot(), duration.NewRelative(duration.NameSixteenth)},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, n := range notes[:4] {
+		fmt.Println(n.Name(), n.Octave().Number(), n.Value().Name(), n.Value().Dots())
+	}
+	// Output: G 3 Eighth 1
+	// B 3 Sixteenth 0
+	// A 3 Eighth 1
+	// C 4 Sixteenth 0
+}
@@ -0,0 +1,142 @@
+package exercise
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/common/fraction"
+	"github.com/go-muse/muse/duration"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/scale"
+	"github.com/go-muse/muse/track"
+)
+
+// namesWithOctaves returns names of the notes with their octaves separated by spaces, e.g. "C4 E4".
+func namesWithOctaves(notes note.Notes) string {
+	names := make([]string, 0, len(notes))
+	for _, n := range notes {
+		names = append(names, fmt.Sprintf("%s%d", n.Name(), n.Octave().Number()))
+	}
+
+	return strings.Join(names, " ")
+}
+
+func cMajor(t *testing.T) scale.Scale {
+	t.Helper()
+
+	s, err := mode.MustMakeNewMode(mode.NameNaturalMajor, note.C).GenerateScaleOctaves(note.MustNewNoteWithOctave(note.C, 4), 1, mode.ScaleAscending)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pattern   Pattern
+		direction mode.ScaleDirection
+		expected  string
+	}{
+		{
+			name: "thirds", pattern: Intervals(StepsThird), direction: mode.ScaleAscending,
+			expected: "C4 E4 D4 F4 E4 G4 F4 A4 G4 B4 A4 C5",
+		},
+		{
+			name: "fourths descending", pattern: Intervals(StepsFourth), direction: mode.ScaleDescending,
+			expected: "C5 G4 B4 F4 A4 E4 G4 D4 F4 C4",
+		},
+		{
+			name: "sixths", pattern: Intervals(StepsSixth), direction: mode.ScaleAscending,
+			expected: "C4 A4 D4 B4 E4 C5",
+		},
+		{
+			name: "groups of three up and down", pattern: Groups(3), direction: mode.ScaleAscendingDescending,
+			expected: "C4 D4 E4 D4 E4 F4 E4 F4 G4 F4 G4 A4 G4 A4 B4 A4 B4 C5 " +
+				"C5 B4 A4 B4 A4 G4 A4 G4 F4 G4 F4 E4 F4 E4 D4 E4 D4 C4",
+		},
+		{
+			name: "groups of four", pattern: Groups(4), direction: mode.ScaleAscending,
+			expected: "C4 D4 E4 F4 D4 E4 F4 G4 E4 F4 G4 A4 F4 G4 A4 B4 G4 A4 B4 C5",
+		},
+		{
+			name: "broken triads", pattern: BrokenChords(3), direction: mode.ScaleAscending,
+			expected: "C4 E4 G4 D4 F4 A4 E4 G4 B4 F4 A4 C5",
+		},
+		{
+			name: "broken triads descending", pattern: BrokenChords(3), direction: mode.ScaleDescending,
+			expected: "C5 A4 F4 B4 G4 E4 A4 F4 D4 G4 E4 C4",
+		},
+		{
+			name: "enclosures", pattern: Enclosures(), direction: mode.ScaleAscending,
+			expected: "E4 C#4 D4 F4 D#4 E4 G4 E4 F4 A4 F#4 G4 B4 G#4 A4 C5 A#4 B4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			notes, err := Generate(cMajor(t), Options{Pattern: testCase.pattern, Direction: testCase.direction})
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, namesWithOctaves(notes))
+		})
+	}
+
+	t.Run("Generate: rhythm is repeated over the notes", func(t *testing.T) {
+		rhythm := []*duration.Relative{duration.NewRelative(duration.NameEighth).AddDot(), duration.NewRelative(duration.NameSixteenth)}
+		notes, err := Generate(cMajor(t), Options{Pattern: Intervals(StepsThird), Rhythm: rhythm})
+		require.NoError(t, err)
+		for i, n := range notes {
+			assert.Equal(t, rhythm[i%2], n.Value())
+		}
+	})
+
+	t.Run("Generate: the scale is not changed", func(t *testing.T) {
+		s := cMajor(t)
+		_, err := Generate(s, Options{Pattern: Groups(3), Rhythm: []*duration.Relative{duration.NewRelative(duration.NameQuarter)}})
+		require.NoError(t, err)
+		for _, n := range s {
+			assert.Nil(t, n.Value())
+		}
+	})
+
+	t.Run("Generate: invalid scales", func(t *testing.T) {
+		_, err := Generate(scale.MustNewScaleFromNoteNames(note.C, note.D), Options{Pattern: Groups(3)})
+		require.ErrorIs(t, err, ErrScaleInvalid)
+
+		_, err = Generate(scale.Scale{note.MustNewNoteWithOctave(note.D, 4), note.MustNewNoteWithOctave(note.C, 4)}, Options{Pattern: Groups(3)})
+		require.ErrorIs(t, err, ErrScaleInvalid)
+
+		_, err = Generate(cMajor(t), Options{})
+		require.ErrorIs(t, err, ErrScaleInvalid)
+	})
+}
+
+func TestGenerateFromMode(t *testing.T) {
+	notes, err := GenerateFromMode(mode.MustMakeNewMode(mode.NamePentatonicMinor, note.A), note.MustNewNoteWithOctave(note.A, 3), 2, Options{Pattern: Groups(4)})
+	require.NoError(t, err)
+	assert.Equal(t, "A3 C4 D4 E4 C4 D4 E4 G4 D4 E4 G4 A4 E4 G4 A4 C5 G4 A4 C5 D5 A4 C5 D5 E5 C5 D5 E5 G5 D5 E5 G5 A5", namesWithOctaves(notes))
+
+	_, err = GenerateFromMode(mode.MustMakeNewMode(mode.NamePentatonicMinor, note.A), note.A.MustNewNote(), 2, Options{Pattern: Groups(4)})
+	require.ErrorIs(t, err, mode.ErrScaleRangeInvalid)
+}
+
+func TestNewTrack(t *testing.T) {
+	settings := &track.Settings{BPM: 120, Unit: *fraction.New(1, 4), TimeSignature: *fraction.New(4, 4)}
+	notes, err := Generate(cMajor(t), Options{
+		Pattern: Intervals(StepsThird),
+		Rhythm:  []*duration.Relative{duration.NewRelative(duration.NameEighth)},
+	})
+	require.NoError(t, err)
+
+	tr := NewTrack(notes, settings)
+	require.Len(t, tr.Events(), len(notes))
+	for i, event := range tr.Events() {
+		assert.Equal(t, notes[i], event.Note())
+		start, end := tr.GetStartAndEnd(event)
+		assert.Equal(t, int64(i)*int64(end-start), int64(start))
+	}
+}
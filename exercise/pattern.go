@@ -0,0 +1,93 @@
+package exercise
+
+import (
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/scale"
+)
+
+// Pattern returns the group of notes built on the note of the ascending scale with the given index,
+// going up or down the scale. It returns false if the group goes out of the scale.
+type Pattern func(notes scale.Scale, i int, down bool) (note.Notes, bool)
+
+const (
+	// StepsThird is amount of scale steps in the third.
+	StepsThird = 2
+	// StepsFourth is amount of scale steps in the fourth.
+	StepsFourth = 3
+	// StepsSixth is amount of scale steps in the sixth.
+	StepsSixth = 5
+)
+
+// pick returns copies of the notes of the scale with the given indexes or false if any index is out of the scale.
+func pick(notes scale.Scale, indexes ...int) (note.Notes, bool) {
+	picked := make(note.Notes, 0, len(indexes))
+	for _, i := range indexes {
+		if i < 0 || i >= len(notes) {
+			return nil, false
+		}
+
+		picked = append(picked, notes[i].Copy())
+	}
+
+	return picked, true
+}
+
+// direction returns the sign of the index shift in the given direction.
+func direction(down bool) int {
+	if down {
+		return -1
+	}
+
+	return 1
+}
+
+// Intervals makes pairs of notes the given amount of scale steps away, e.g. the sequence in thirds C E, D F, E G.
+func Intervals(steps int) Pattern {
+	return func(notes scale.Scale, i int, down bool) (note.Notes, bool) {
+		return pick(notes, i, i+direction(down)*steps)
+	}
+}
+
+// Groups makes groups of the given amount of neighbouring notes, e.g. groups of three C D E, D E F, E F G.
+func Groups(size int) Pattern {
+	return func(notes scale.Scale, i int, down bool) (note.Notes, bool) {
+		indexes := make([]int, 0, size)
+		for j := range size {
+			indexes = append(indexes, i+direction(down)*j)
+		}
+
+		return pick(notes, indexes...)
+	}
+}
+
+// BrokenChords makes chords of the given amount of notes stacked in thirds on each degree, e.g. triads C E G, D F A, E G B.
+// Descending chords are stacked down from the degree.
+func BrokenChords(size int) Pattern {
+	return func(notes scale.Scale, i int, down bool) (note.Notes, bool) {
+		indexes := make([]int, 0, size)
+		for j := range size {
+			indexes = append(indexes, i+direction(down)*j*StepsThird)
+		}
+
+		return pick(notes, indexes...)
+	}
+}
+
+// Enclosures surround each note by the scale note above it and the chromatic note a halftone below it, e.g. E C# D.
+// Notes without the scale note above or with the chromatic note below the scale are skipped.
+func Enclosures() Pattern {
+	return func(notes scale.Scale, i int, _ bool) (note.Notes, bool) {
+		picked, ok := pick(notes, i+1, i)
+		if !ok {
+			return nil, false
+		}
+
+		target := picked[1]
+		below, err := target.TransposeBySpelling(-1, -1)
+		if err != nil || below.Compare(notes[0]) < 0 {
+			return nil, false
+		}
+
+		return note.Notes{picked[0], below, target}, true
+	}
+}
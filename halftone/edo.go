@@ -0,0 +1,116 @@
+package halftone
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// EDO is an equal division of the octave: the amount of equal steps the octave is divided into.
+// The usual twelve-tone equal temperament is 12-EDO, and its step is a halftone.
+// In other divisions the values of HalfTones type are counted in steps of the division.
+type EDO uint8
+
+// Commonly used divisions of the octave.
+const (
+	EDO12 = EDO(HalfTonesInOctave) // Twelve-tone equal temperament
+	EDO17 = EDO(17)
+	EDO19 = EDO(19) // Close to the 1/3-comma meantone
+	EDO22 = EDO(22)
+	EDO24 = EDO(24) // Quarter tones
+	EDO31 = EDO(31) // Close to the 1/4-comma meantone
+	EDO53 = EDO(53) // Holdrian commas of the Turkish music theory
+)
+
+// centsInOctave is amount of cents in octave.
+const centsInOctave = 1200.0
+
+// ErrInvalidEDO is returned when the division of the octave is zero.
+var ErrInvalidEDO = errors.New("invalid division of octave")
+
+// Validate checks that the octave is divided into at least one step.
+func (e EDO) Validate() error {
+	if e == 0 {
+		return fmt.Errorf("zero steps in octave: %w", ErrInvalidEDO)
+	}
+
+	return nil
+}
+
+// StepsInOctave returns amount of steps in octave.
+func (e EDO) StepsInOctave() HalfTones {
+	return HalfTones(e)
+}
+
+// StepCents returns the size of one step in cents.
+func (e EDO) StepCents() float64 {
+	if e == 0 {
+		return 0
+	}
+
+	return centsInOctave / float64(e)
+}
+
+// Cents returns the size of the given amount of steps in cents. Negative steps go down.
+func (e EDO) Cents(steps int) float64 {
+	return float64(steps) * e.StepCents()
+}
+
+// Ratio returns the frequency ratio of the given amount of steps.
+func (e EDO) Ratio(steps int) float64 {
+	if e == 0 {
+		return 1
+	}
+
+	return math.Pow(2, float64(steps)/float64(e)) //nolint:mnd
+}
+
+// Frequency returns the frequency of a pitch that is the given amount of steps away from the reference frequency.
+func (e EDO) Frequency(reference float64, steps int) float64 {
+	return reference * e.Ratio(steps)
+}
+
+// NearestSteps returns the amount of steps closest to the given size in cents.
+func (e EDO) NearestSteps(cents float64) int {
+	if e == 0 {
+		return 0
+	}
+
+	return int(math.Round(cents / e.StepCents()))
+}
+
+// Fifth returns the amount of steps of the best approximation of the perfect fifth (3/2).
+func (e EDO) Fifth() HalfTones {
+	return HalfTones(e.NearestSteps(centsInOctave * math.Log2(1.5))) //nolint:mnd
+}
+
+// WholeTone returns the amount of steps of the whole tone generated by two fifths minus octave.
+func (e EDO) WholeTone() HalfTones {
+	return HalfTones(2*int(e.Fifth()) - int(e)) //nolint:mnd,gosec // the fifth is always more than a half of octave
+}
+
+// DiatonicSemitone returns the amount of steps of the diatonic semitone (minor second, E-F),
+// so that five whole tones and two diatonic semitones make the octave.
+func (e EDO) DiatonicSemitone() HalfTones {
+	const wholeTonesInOctave, semitonesInOctave = 5, 2
+	if wholeTonesInOctave*int(e.WholeTone()) > int(e) {
+		return 0
+	}
+
+	return (e.StepsInOctave() - wholeTonesInOctave*e.WholeTone()) / semitonesInOctave
+}
+
+// ChromaticSemitone returns the amount of steps of the chromatic semitone (augmented unison, F-F#).
+// It is zero in divisions that do not distinguish sharps from the natural notes.
+func (e EDO) ChromaticSemitone() HalfTones {
+	if e.DiatonicSemitone() > e.WholeTone() {
+		return 0
+	}
+
+	return e.WholeTone() - e.DiatonicSemitone()
+}
+
+// IsMultipleOf12 checks if the division contains every halftone of the twelve-tone equal temperament.
+func (e EDO) IsMultipleOf12() bool {
+	return e != 0 && e%EDO12 == 0
+}
@@ -0,0 +1,65 @@
+package halftone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEDOSteps(t *testing.T) {
+	tests := []struct {
+		name              string
+		edo               EDO
+		fifth             HalfTones
+		wholeTone         HalfTones
+		diatonicSemitone  HalfTones
+		chromaticSemitone HalfTones
+	}{
+		{name: "12-EDO", edo: EDO12, fifth: 7, wholeTone: 2, diatonicSemitone: 1, chromaticSemitone: 1},
+		{name: "17-EDO", edo: EDO17, fifth: 10, wholeTone: 3, diatonicSemitone: 1, chromaticSemitone: 2},
+		{name: "19-EDO", edo: EDO19, fifth: 11, wholeTone: 3, diatonicSemitone: 2, chromaticSemitone: 1},
+		{name: "22-EDO", edo: EDO22, fifth: 13, wholeTone: 4, diatonicSemitone: 1, chromaticSemitone: 3},
+		{name: "24-EDO", edo: EDO24, fifth: 14, wholeTone: 4, diatonicSemitone: 2, chromaticSemitone: 2},
+		{name: "31-EDO", edo: EDO31, fifth: 18, wholeTone: 5, diatonicSemitone: 3, chromaticSemitone: 2},
+		{name: "53-EDO", edo: EDO53, fifth: 31, wholeTone: 9, diatonicSemitone: 4, chromaticSemitone: 5},
+		{name: "7-EDO", edo: EDO(7), fifth: 4, wholeTone: 1, diatonicSemitone: 1, chromaticSemitone: 0},
+		{name: "1-EDO", edo: EDO(1), fifth: 1, wholeTone: 1, diatonicSemitone: 0, chromaticSemitone: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.fifth, tt.edo.Fifth())
+			assert.Equal(t, tt.wholeTone, tt.edo.WholeTone())
+			assert.Equal(t, tt.diatonicSemitone, tt.edo.DiatonicSemitone())
+			assert.Equal(t, tt.chromaticSemitone, tt.edo.ChromaticSemitone())
+		})
+	}
+}
+
+func TestEDOCentsAndFrequency(t *testing.T) {
+	assert.InDelta(t, 100.0, EDO12.StepCents(), 0.000001)
+	assert.InDelta(t, 50.0, EDO24.StepCents(), 0.000001)
+	assert.InDelta(t, 1200.0/31, EDO31.StepCents(), 0.000001)
+	assert.InDelta(t, -300.0, EDO12.Cents(-3), 0.000001)
+	assert.InDelta(t, 2.0, EDO19.Ratio(19), 0.000001)
+	assert.InDelta(t, 880.0, EDO31.Frequency(440, 31), 0.000001)
+	assert.InDelta(t, 220.0, EDO24.Frequency(440, -24), 0.000001)
+	assert.InDelta(t, 659.255, EDO12.Frequency(440, 7), 0.001)
+	assert.Equal(t, 11, EDO19.NearestSteps(700))
+	assert.Equal(t, -2, EDO24.NearestSteps(-110))
+
+	assert.Zero(t, EDO(0).StepCents())
+	assert.InDelta(t, 1.0, EDO(0).Ratio(5), 0.000001)
+	assert.Zero(t, EDO(0).NearestSteps(100))
+}
+
+func TestEDOValidate(t *testing.T) {
+	require.NoError(t, EDO19.Validate())
+	require.ErrorIs(t, EDO(0).Validate(), ErrInvalidEDO)
+
+	assert.True(t, EDO12.IsMultipleOf12())
+	assert.True(t, EDO24.IsMultipleOf12())
+	assert.False(t, EDO19.IsMultipleOf12())
+	assert.False(t, EDO(0).IsMultipleOf12())
+}
@@ -0,0 +1,129 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/halftone"
+)
+
+// ErrIntervalTooNarrow is returned when the interval can't be reduced by the given amount of octaves.
+var ErrIntervalTooNarrow = errors.New("interval is too narrow")
+
+// signed returns amount of halftones and degrees of the interval, negative for descending one.
+func (ic *Chromatic) signed() (halfTones, degrees int) {
+	if ic == nil {
+		return 0, 0
+	}
+
+	halfTones, degrees = int(ic.halfTones), int(ic.degrees)
+	if ic.direction == DirectionDescending {
+		return -halfTones, -degrees
+	}
+
+	return halfTones, degrees
+}
+
+// Invert returns the inversion of the interval: the interval that completes it to the octave,
+// e.g. major third becomes minor sixth, augmented fourth becomes diminished fifth, unison becomes octave.
+// Compound intervals are reduced to simple ones before inversion. Direction of the interval is kept.
+func (ic *Chromatic) Invert() (*Chromatic, error) {
+	simple, err := ic.Simple()
+	if err != nil {
+		return nil, err
+	}
+
+	halfTones := int(halftone.HalfTonesInOctave) - int(simple.halfTones)
+	degrees := int(DegreesInOctave) - int(simple.degrees)
+	if halfTones < 0 {
+		return nil, fmt.Errorf("invert interval '%s': %w", ic.Name(), ErrIntervalQualityUnknown)
+	}
+
+	if ic.direction == DirectionDescending {
+		halfTones, degrees = -halfTones, -degrees
+	}
+
+	return newIntervalBySignedSpelling(halfTones, degrees)
+}
+
+// Add returns the sum of the intervals, e.g. major third plus minor third is perfect fifth.
+// Descending intervals are subtracted, so the result may be descending.
+func (ic *Chromatic) Add(other *Chromatic) (*Chromatic, error) {
+	if ic == nil || other == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	halfTones1, degrees1 := ic.signed()
+	halfTones2, degrees2 := other.signed()
+
+	sum, err := newIntervalBySignedSpelling(halfTones1+halfTones2, degrees1+degrees2)
+	if err != nil {
+		return nil, fmt.Errorf("add interval '%s' to '%s': %w", other.Name(), ic.Name(), err)
+	}
+
+	return sum, nil
+}
+
+// Sub returns the difference of the intervals, e.g. perfect fifth minus major third is minor third.
+// If the subtracted interval is wider, the result is descending.
+func (ic *Chromatic) Sub(other *Chromatic) (*Chromatic, error) {
+	if ic == nil || other == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	halfTones1, degrees1 := ic.signed()
+	halfTones2, degrees2 := other.signed()
+
+	difference, err := newIntervalBySignedSpelling(halfTones1-halfTones2, degrees1-degrees2)
+	if err != nil {
+		return nil, fmt.Errorf("subtract interval '%s' from '%s': %w", other.Name(), ic.Name(), err)
+	}
+
+	return difference, nil
+}
+
+// Compound returns the interval widened by the given amount of octaves, e.g. major second compounded by one octave is major ninth.
+// Direction of the interval is kept.
+func (ic *Chromatic) Compound(octaves uint8) (*Chromatic, error) {
+	if ic == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	halfTones := int(ic.halfTones) + int(octaves)*int(halftone.HalfTonesInOctave)
+	degrees := int(ic.degrees) + int(octaves)*int(DegreesInOctave)
+	if ic.direction == DirectionDescending {
+		halfTones, degrees = -halfTones, -degrees
+	}
+
+	return newIntervalBySignedSpelling(halfTones, degrees)
+}
+
+// Reduce returns the interval narrowed by the given amount of octaves, e.g. major ninth reduced by one octave is major second.
+// Direction of the interval is kept. Use Simple to reduce the interval by all its octaves.
+// Diminished octaves and their compounds can't be reduced to unisons, as the diminished unison is unknown: ErrIntervalQualityUnknown is returned.
+func (ic *Chromatic) Reduce(octaves uint8) (*Chromatic, error) {
+	if ic == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	if octaves > ic.Octaves() {
+		return nil, fmt.Errorf("reduce interval '%s' by %d octaves: %w", ic.Name(), octaves, ErrIntervalTooNarrow)
+	}
+
+	halfTones := int(ic.halfTones) - int(octaves)*int(halftone.HalfTonesInOctave)
+	degrees := int(ic.degrees) - int(octaves)*int(DegreesInOctave)
+	if halfTones < 0 {
+		return nil, fmt.Errorf("reduce interval '%s' by %d octaves: %w", ic.Name(), octaves, ErrIntervalQualityUnknown)
+	}
+
+	if ic.direction == DirectionDescending {
+		halfTones, degrees = -halfTones, -degrees
+	}
+
+	reduced, err := newIntervalBySignedSpelling(halfTones, degrees)
+	if err != nil {
+		return nil, fmt.Errorf("reduce interval '%s' by %d octaves: %w", ic.Name(), octaves, err)
+	}
+
+	return reduced, nil
+}
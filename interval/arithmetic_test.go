@@ -0,0 +1,173 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+)
+
+func TestChromatic_Invert(t *testing.T) {
+	testCases := []struct {
+		interval *Chromatic
+		want     Name
+	}{
+		{interval: MajorThird(), want: NameMinorSixth},
+		{interval: MinorThird(), want: NameMajorSixth},
+		{interval: AugmentedFourth(), want: NameDiminishedFifth},
+		{interval: DiminishedFifth(), want: NameAugmentedFourth},
+		{interval: PerfectFourth(), want: NamePerfectFifth},
+		{interval: MajorSeventh(), want: NameMinorSecond},
+		{interval: PerfectUnison(), want: NamePerfectOctave},
+		{interval: PerfectOctave(), want: NamePerfectUnison},
+		{interval: AugmentedUnison(), want: NameDiminishedOctave},
+		{interval: MajorNinth(), want: NameMinorSeventh},
+		{interval: OctaveWithTritone(), want: NameDiminishedFifth},
+		{interval: mustNewIntervalBySpelling(18, 11), want: NameAugmentedFourth},
+		{interval: mustNewIntervalBySpelling(15, 8), want: NameDiminishedSeventh},
+	}
+
+	for _, testCase := range testCases {
+		inverted, err := testCase.interval.Invert()
+		require.NoError(t, err, testCase.interval.Name())
+		assert.Equal(t, testCase.want, inverted.Name(), testCase.interval.Name())
+	}
+
+	augmentedOctave, err := NewIntervalBySpelling(13, 7)
+	require.NoError(t, err)
+	_, err = augmentedOctave.Invert()
+	require.ErrorIs(t, err, ErrIntervalQualityUnknown)
+
+	_, err = (*Chromatic)(nil).Invert()
+	require.ErrorIs(t, err, ErrIntervalUnknown)
+}
+
+func TestChromatic_AddSub(t *testing.T) {
+	testCases := []struct {
+		interval1, interval2 *Chromatic
+		sum, difference      Name
+		differenceDirection  Direction
+	}{
+		{
+			interval1: MajorThird(), interval2: MinorThird(),
+			sum: NamePerfectFifth, difference: NameAugmentedUnison,
+		},
+		{
+			interval1: PerfectFifth(), interval2: MajorThird(),
+			sum: NameMajorSeventh, difference: NameMinorThird,
+		},
+		{
+			interval1: MajorThird(), interval2: MajorThird(),
+			sum: NameAugmentedFifth, difference: NamePerfectUnison,
+		},
+		{
+			interval1: MajorSecond(), interval2: PerfectFourth(),
+			sum: NamePerfectFifth, difference: NameMinorThird, differenceDirection: DirectionDescending,
+		},
+		{
+			interval1: PerfectFifth(), interval2: PerfectFifth(),
+			sum: NameMajorNinth, difference: NamePerfectUnison,
+		},
+		{
+			interval1: PerfectOctave(), interval2: AugmentedFourth(),
+			sum: "AugmentedEleventh", difference: NameDiminishedFifth,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sum, err := testCase.interval1.Add(testCase.interval2)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.sum, sum.Name(), "%s + %s", testCase.interval1.Name(), testCase.interval2.Name())
+		assert.Equal(t, DirectionAscending, sum.Direction())
+
+		difference, err := testCase.interval1.Sub(testCase.interval2)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.difference, difference.Name(), "%s - %s", testCase.interval1.Name(), testCase.interval2.Name())
+		assert.Equal(t, testCase.differenceDirection, difference.Direction(), "%s - %s", testCase.interval1.Name(), testCase.interval2.Name())
+	}
+
+	descendingFourth, err := MajorSecond().Sub(PerfectFifth())
+	require.NoError(t, err)
+	sum, err := MajorSixth().Add(descendingFourth)
+	require.NoError(t, err)
+	assert.Equal(t, NameMajorThird, sum.Name())
+	assert.Equal(t, DirectionAscending, sum.Direction())
+
+	_, err = MajorThird().Add(nil)
+	require.ErrorIs(t, err, ErrIntervalUnknown)
+
+	_, err = MajorSixth().Add(MajorSixth())
+	require.NoError(t, err)
+
+	_, err = AugmentedFourth().Add(AugmentedFourth())
+	require.NoError(t, err)
+
+	augmentedSecond, err := NewIntervalBySpelling(3, 1)
+	require.NoError(t, err)
+	_, err = DiminishedSecond().Sub(augmentedSecond)
+	require.ErrorIs(t, err, ErrIntervalQualityUnknown)
+}
+
+func TestChromatic_CompoundReduce(t *testing.T) {
+	compound, err := MajorSecond().Compound(1)
+	require.NoError(t, err)
+	assert.Equal(t, NameMajorNinth, compound.Name())
+
+	compound, err = AugmentedFourth().Compound(2)
+	require.NoError(t, err)
+	assert.Equal(t, Name("AugmentedEighteenth"), compound.Name())
+	assert.Equal(t, uint8(2), compound.Octaves())
+
+	reduced, err := compound.Reduce(1)
+	require.NoError(t, err)
+	assert.Equal(t, Name("AugmentedEleventh"), reduced.Name())
+
+	reduced, err = compound.Reduce(2)
+	require.NoError(t, err)
+	assert.Equal(t, NameAugmentedFourth, reduced.Name())
+
+	_, err = compound.Reduce(3)
+	require.ErrorIs(t, err, ErrIntervalTooNarrow)
+
+	reduced, err = OctaveWithTritone().Reduce(1)
+	require.NoError(t, err)
+	assert.Equal(t, NameAugmentedFourth, reduced.Name())
+	assert.Equal(t, HalfTones6, reduced.HalfTones())
+
+	reduced, err = mustNewIntervalBySpelling(18, 11).Reduce(1)
+	require.NoError(t, err)
+	assert.Equal(t, NameDiminishedFifth, reduced.Name())
+
+	reduced, err = mustNewIntervalBySpelling(25, 14).Reduce(1)
+	require.NoError(t, err)
+	assert.Equal(t, Name("AugmentedOctave"), reduced.Name())
+
+	for _, shorthand := range []string{"d8", "-d8", "d15"} {
+		_, err = MustParse(shorthand).Reduce(MustParse(shorthand).Octaves())
+		require.ErrorIs(t, err, ErrIntervalQualityUnknown, shorthand)
+	}
+
+	reduced, err = MustParse("-d15").Reduce(1)
+	require.NoError(t, err)
+	assert.Equal(t, NameDiminishedOctave, reduced.Name())
+	assert.Equal(t, DirectionDescending, reduced.Direction())
+
+	descending, err := PerfectUnison().Sub(MinorThird())
+	require.NoError(t, err)
+	compound, err = descending.Compound(1)
+	require.NoError(t, err)
+	assert.Equal(t, NameMinorTenth, compound.Name())
+	assert.Equal(t, DirectionDescending, compound.Direction())
+}
+
+func mustNewIntervalBySpelling(halfTones halftone.HalfTones, degrees degree.Number) *Chromatic {
+	ic, err := NewIntervalBySpelling(halfTones, degrees)
+	if err != nil {
+		panic(err)
+	}
+
+	return ic
+}
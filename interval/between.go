@@ -0,0 +1,278 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+// Direction is the direction of an interval.
+type Direction uint8
+
+const (
+	DirectionAscending Direction = iota
+	DirectionDescending
+)
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	if d == DirectionDescending {
+		return "descending"
+	}
+
+	return "ascending"
+}
+
+// Quality is the quality of an interval: perfect, major, minor, augmented or diminished.
+type Quality string
+
+const (
+	QualityPerfect          = Quality("Perfect")
+	QualityMajor            = Quality("Major")
+	QualityMinor            = Quality("Minor")
+	QualityAugmented        = Quality("Augmented")
+	QualityDiminished       = Quality("Diminished")
+	QualityDoublyAugmented  = Quality("DoublyAugmented")
+	QualityDoublyDiminished = Quality("DoublyDiminished")
+)
+
+// getQualityShortNames returns short names of the qualities used in the short names of the intervals.
+func getQualityShortNames() map[Quality]Name {
+	return map[Quality]Name{
+		QualityPerfect:          "P",
+		QualityMajor:            "M",
+		QualityMinor:            "m",
+		QualityAugmented:        "A",
+		QualityDiminished:       "d",
+		QualityDoublyAugmented:  "AA",
+		QualityDoublyDiminished: "dd",
+	}
+}
+
+// DegreesInOctave is amount of degrees between the notes of the octave in the diatonic scale.
+const DegreesInOctave = degree.Number(7)
+
+// getOrdinalNames returns ordinal names of the intervals by the amount of degrees between their notes.
+func getOrdinalNames() []Name {
+	return []Name{
+		"Unison", "Second", "Third", "Fourth", "Fifth", "Sixth", "Seventh", "Octave",
+		"Ninth", "Tenth", "Eleventh", "Twelfth", "Thirteenth", "Fourteenth", "Fifteenth",
+		"Sixteenth", "Seventeenth", "Eighteenth", "Nineteenth", "Twentieth", "TwentyFirst", "TwentySecond",
+	}
+}
+
+// getMajorScaleHalfTones returns halftones from the prime to each degree of the major scale.
+func getMajorScaleHalfTones() []halftone.HalfTones {
+	return []halftone.HalfTones{0, 2, 4, 5, 7, 9, 11}
+}
+
+// isPerfectDegree checks if the interval with the given amount of degrees is from the perfect group (unison, fourth, fifth).
+func isPerfectDegree(degrees degree.Number) bool {
+	simple := degrees % DegreesInOctave
+
+	return simple == 0 || simple == 3 || simple == 4
+}
+
+// ErrIntervalQualityUnknown is returned when the interval is more than doubly augmented or diminished.
+var ErrIntervalQualityUnknown = errors.New("unknown interval quality")
+
+// referenceHalfTones returns halftones of the major or perfect interval with the given amount of degrees.
+func referenceHalfTones(degrees degree.Number) int {
+	octaves := int(degrees / DegreesInOctave)
+
+	return int(getMajorScaleHalfTones()[degrees%DegreesInOctave]) + octaves*int(halftone.HalfTonesInOctave)
+}
+
+// qualityByHalfTonesAndDegrees returns the quality of the interval with the given halftones and amount of degrees.
+func qualityByHalfTonesAndDegrees(halfTones int, degrees degree.Number) (Quality, error) {
+	diff := halfTones - referenceHalfTones(degrees)
+
+	if isPerfectDegree(degrees) {
+		switch diff {
+		case 0:
+			return QualityPerfect, nil
+		case 1:
+			return QualityAugmented, nil
+		case -1:
+			return QualityDiminished, nil
+		case 2: //nolint:mnd
+			return QualityDoublyAugmented, nil
+		case -2: //nolint:mnd
+			return QualityDoublyDiminished, nil
+		}
+	} else {
+		switch diff {
+		case 0:
+			return QualityMajor, nil
+		case -1:
+			return QualityMinor, nil
+		case 1:
+			return QualityAugmented, nil
+		case -2: //nolint:mnd
+			return QualityDiminished, nil
+		case 2: //nolint:mnd
+			return QualityDoublyAugmented, nil
+		case -3: //nolint:mnd
+			return QualityDoublyDiminished, nil
+		}
+	}
+
+	return "", fmt.Errorf("halftones: '%d', degrees: '%d': %w", halfTones, degrees, ErrIntervalQualityUnknown)
+}
+
+// ordinalName returns ordinal name of the interval by amount of degrees.
+func ordinalName(degrees degree.Number) Name {
+	ordinalNames := getOrdinalNames()
+	if int(degrees) < len(ordinalNames) {
+		return ordinalNames[degrees]
+	}
+
+	number := degrees + 1
+	suffix := "th"
+	if number%100 < 11 || number%100 > 13 { //nolint:mnd
+		switch number % 10 { //nolint:mnd
+		case 1:
+			suffix = "st"
+		case 2: //nolint:mnd
+			suffix = "nd"
+		case 3: //nolint:mnd
+			suffix = "rd"
+		}
+	}
+
+	return Name(fmt.Sprintf("%d%s", number, suffix))
+}
+
+// NewIntervalBySpelling creates interval by halftones and amount of degrees between its notes.
+// Unlike NewIntervalByHalfTonesAndDegrees it works with intervals of any size and up to doubly augmented or diminished ones.
+// Intervals known by the library are returned with their usual names.
+func NewIntervalBySpelling(halfTones halftone.HalfTones, degrees degree.Number) (*Chromatic, error) {
+	quality, err := qualityByHalfTonesAndDegrees(int(halfTones), degrees)
+	if err != nil {
+		return nil, err
+	}
+
+	// The usual intervals are created by their own constructors
+	if degrees <= DegreesInOctave && halfTones <= HalfTones12 {
+		if ic, err := NewIntervalByHalfTonesAndDegrees(halfTones, degrees); err == nil {
+			return ic, nil
+		}
+	}
+
+	isUsualCompound := quality == QualityPerfect || quality == QualityMajor || quality == QualityMinor
+	if isUsualCompound && halfTones > HalfTones12 && halfTones <= HalfTones24 {
+		if ic, err := NewChromatic(halfTones); err == nil && ic.degrees == degrees {
+			return ic, nil
+		}
+	}
+
+	return &Chromatic{
+		Sonance: SonanceByHalfTones(halfTones),
+		names: &nameExtended{
+			name:      Name(quality) + ordinalName(degrees),
+			shortName: getQualityShortNames()[quality] + Name(fmt.Sprint(degrees+1)),
+		},
+		halfTones: halfTones,
+		degrees:   degrees,
+	}, nil
+}
+
+// ErrNoteEmpty is returned when nil note was given as a parameter.
+var ErrNoteEmpty = errors.New("empty note")
+
+// ErrMicrotonalInterval is returned when the interval between notes with quarter-tone accidentals is requested.
+var ErrMicrotonalInterval = errors.New("interval between microtonal notes")
+
+// Between returns the interval between two notes spelled by their letter names,
+// so C-D# is an augmented second and C-Eb is a minor third.
+// If both notes have octaves, the interval may be compound and descending.
+// Otherwise, the second note is considered as the nearest note above the first one (or the same one).
+func Between(n1, n2 *note.Note) (*Chromatic, error) {
+	if n1 == nil || n2 == nil {
+		return nil, ErrNoteEmpty
+	}
+
+	if n1.IsMicrotonal() || n2.IsMicrotonal() {
+		return nil, fmt.Errorf("notes '%s' and '%s': %w", n1.Name(), n2.Name(), ErrMicrotonalInterval)
+	}
+
+	degrees := n2.DiatonicPosition() - n1.DiatonicPosition()
+	halfTones := n2.ChromaticPosition() - n1.ChromaticPosition()
+
+	// Without octaves the second note is placed above the first one, so C#-C is a diminished octave
+	if n1.Octave() == nil || n2.Octave() == nil {
+		degrees = n2.BaseNameIndex() - n1.BaseNameIndex()
+		halfTones = n2.Copy().SetOctave(nil).ChromaticPosition() - n1.Copy().SetOctave(nil).ChromaticPosition()
+		if degrees < 0 || (degrees == 0 && halfTones < 0) {
+			degrees += int(DegreesInOctave)
+			halfTones += int(halftone.HalfTonesInOctave)
+		}
+	}
+
+	ic, err := newIntervalBySignedSpelling(halfTones, degrees)
+	if err != nil {
+		return nil, fmt.Errorf("notes '%s' and '%s': %w", n1.Name(), n2.Name(), err)
+	}
+
+	return ic, nil
+}
+
+// newIntervalBySignedSpelling creates interval by signed amount of halftones and degrees.
+// Negative amounts make descending interval.
+func newIntervalBySignedSpelling(halfTones, degrees int) (*Chromatic, error) {
+	direction := DirectionAscending
+	if degrees < 0 || (degrees == 0 && halfTones < 0) {
+		direction = DirectionDescending
+		degrees, halfTones = -degrees, -halfTones
+	}
+
+	if halfTones < 0 {
+		return nil, fmt.Errorf("halftones: '%d', degrees: '%d': %w", halfTones, degrees, ErrIntervalQualityUnknown)
+	}
+
+	ic, err := NewIntervalBySpelling(halftone.HalfTones(halfTones), degree.Number(degrees)) //nolint:gosec // intervals are within MIDI range
+	if err != nil {
+		return nil, err
+	}
+
+	ic.direction = direction
+
+	return ic, nil
+}
+
+// IsCompound checks if the interval is wider than an octave.
+func (ic *Chromatic) IsCompound() bool {
+	return ic.Degrees() > DegreesInOctave
+}
+
+// Octaves returns amount of whole octaves the interval spans.
+func (ic *Chromatic) Octaves() uint8 {
+	return uint8(ic.Degrees() / DegreesInOctave) //nolint:gosec // degrees are small
+}
+
+// Simple returns the simple interval (within octave) reduced from the compound one, e.g. major ninth becomes major second.
+// The octave and simple intervals stay the same. Direction of the interval is kept.
+func (ic *Chromatic) Simple() (*Chromatic, error) {
+	if ic == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	degrees, halfTones := ic.degrees, ic.halfTones
+	if degrees > DegreesInOctave {
+		octaves := (degrees - 1) / DegreesInOctave
+		degrees -= octaves * DegreesInOctave
+		halfTones -= halftone.HalfTones(octaves) * halftone.HalfTonesInOctave //nolint:gosec // degrees are small
+	}
+
+	simple, err := NewIntervalBySpelling(halfTones, degrees)
+	if err != nil {
+		return nil, err
+	}
+
+	simple.direction = ic.direction
+
+	return simple, nil
+}
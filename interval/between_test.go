@@ -0,0 +1,157 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestBetween(t *testing.T) {
+	testCases := []struct {
+		n1, n2    *note.Note
+		name      Name
+		shortName Name
+		halfTones halftone.HalfTones
+		degrees   degree.Number
+		direction Direction
+	}{
+		{
+			n1: note.MustNewNote(note.C), n2: note.MustNewNote(note.DSHARP),
+			name: NameAugmentedSecond, shortName: NameAugmentedSecondShort, halfTones: 3, degrees: 1,
+		},
+		{
+			n1: note.MustNewNote(note.C), n2: note.MustNewNote(note.EFLAT),
+			name: NameMinorThird, shortName: NameMinorThirdShort, halfTones: 3, degrees: 2,
+		},
+		{
+			n1: note.MustNewNote(note.B), n2: note.MustNewNote(note.C),
+			name: NameMinorSecond, shortName: NameMinorSecondShort, halfTones: 1, degrees: 1,
+		},
+		{
+			n1: note.MustNewNote(note.BSHARP), n2: note.MustNewNote(note.C),
+			name: NameDiminishedSecond, shortName: NameDiminishedSecondShort, halfTones: 0, degrees: 1,
+		},
+		{
+			n1: note.MustNewNote(note.C), n2: note.MustNewNote(note.DSHARP2),
+			name: "DoublyAugmentedSecond", shortName: "AA2", halfTones: 4, degrees: 1,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.C, octave.Number4), n2: note.MustNewNoteWithOctave(note.C, octave.Number4),
+			name: NamePerfectUnison, shortName: NamePerfectUnisonShort, halfTones: 0, degrees: 0,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.C, octave.Number4), n2: note.MustNewNoteWithOctave(note.E, octave.Number5),
+			name: NameMajorTenth, shortName: NameMajorTenthShort, halfTones: 16, degrees: 9,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.C, octave.Number4), n2: note.MustNewNoteWithOctave(note.FSHARP, octave.Number5),
+			name: "AugmentedEleventh", shortName: "A11", halfTones: 18, degrees: 10,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.C, octave.Number4), n2: note.MustNewNoteWithOctave(note.D, octave.Number6),
+			name: "MajorSixteenth", shortName: "M16", halfTones: 26, degrees: 15,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.C, octave.Number5), n2: note.MustNewNoteWithOctave(note.A, octave.Number4),
+			name: NameMinorThird, shortName: NameMinorThirdShort, halfTones: 3, degrees: 2, direction: DirectionDescending,
+		},
+		{
+			n1: note.MustNewNoteWithOctave(note.CSHARP, octave.Number4), n2: note.MustNewNoteWithOctave(note.C, octave.Number4),
+			name: NameAugmentedUnison, shortName: NameAugmentedUnisonShort, halfTones: 1, degrees: 0, direction: DirectionDescending,
+		},
+		{
+			n1: note.MustNewNote(note.CSHARP), n2: note.MustNewNote(note.C),
+			name: NameDiminishedOctave, shortName: NameDiminishedOctaveShort, halfTones: 11, degrees: 7,
+		},
+		{
+			n1: note.MustNewNote(note.CSHARP), n2: note.MustNewNote(note.CFLAT),
+			name: "DoublyDiminishedOctave", shortName: "dd8", halfTones: 10, degrees: 7,
+		},
+		{
+			n1: note.MustNewNote(note.C), n2: note.MustNewNote(note.CSHARP),
+			name: NameAugmentedUnison, shortName: NameAugmentedUnisonShort, halfTones: 1, degrees: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		ic, err := Between(testCase.n1, testCase.n2)
+		require.NoError(t, err, "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+		assert.Equal(t, testCase.name, ic.Name(), "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+		assert.Equal(t, testCase.shortName, ic.ShortName(), "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+		assert.Equal(t, testCase.halfTones, ic.HalfTones(), "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+		assert.Equal(t, testCase.degrees, ic.Degrees(), "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+		assert.Equal(t, testCase.direction, ic.Direction(), "%s - %s", testCase.n1.Name(), testCase.n2.Name())
+	}
+}
+
+func TestBetween_Errors(t *testing.T) {
+	_, err := Between(nil, note.MustNewNote(note.C))
+	require.ErrorIs(t, err, ErrNoteEmpty)
+
+	_, err = Between(note.MustNewNote(note.C), note.MustNewNote(note.DHALFFLAT))
+	require.ErrorIs(t, err, ErrMicrotonalInterval)
+
+	_, err = Between(note.MustNewNote(note.CSHARP2), note.MustNewNote(note.DFLAT2))
+	require.ErrorIs(t, err, ErrIntervalQualityUnknown)
+}
+
+func TestNewIntervalBySpelling(t *testing.T) {
+	ic, err := NewIntervalBySpelling(7, 4)
+	require.NoError(t, err)
+	assert.Equal(t, PerfectFifth(), ic)
+
+	ic, err = NewIntervalBySpelling(13, 7)
+	require.NoError(t, err)
+	assert.Equal(t, Name("AugmentedOctave"), ic.Name())
+	assert.Equal(t, Name("A8"), ic.ShortName())
+
+	ic, err = NewIntervalBySpelling(5, 4)
+	require.NoError(t, err)
+	assert.Equal(t, Name("DoublyDiminishedFifth"), ic.Name())
+
+	_, err = NewIntervalBySpelling(9, 2)
+	require.ErrorIs(t, err, ErrIntervalQualityUnknown)
+}
+
+func TestChromatic_Simple(t *testing.T) {
+	testCases := []struct {
+		halfTones halftone.HalfTones
+		degrees   degree.Number
+		want      Name
+	}{
+		{halfTones: 14, degrees: 8, want: NameMajorSecond},
+		{halfTones: 12, degrees: 7, want: NamePerfectOctave},
+		{halfTones: 24, degrees: 14, want: NamePerfectOctave},
+		{halfTones: 18, degrees: 10, want: NameAugmentedFourth},
+		{halfTones: 26, degrees: 15, want: NameMajorSecond},
+		{halfTones: 38, degrees: 22, want: NameMajorSecond},
+		{halfTones: 3, degrees: 2, want: NameMinorThird},
+	}
+
+	for _, testCase := range testCases {
+		ic, err := NewIntervalBySpelling(testCase.halfTones, testCase.degrees)
+		require.NoError(t, err)
+		simple, err := ic.Simple()
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, simple.Name())
+		assert.False(t, simple.IsCompound())
+	}
+
+	ic, err := Between(note.MustNewNoteWithOctave(note.E, octave.Number5), note.MustNewNoteWithOctave(note.C, octave.Number4))
+	require.NoError(t, err)
+	assert.True(t, ic.IsCompound())
+	assert.Equal(t, uint8(1), ic.Octaves())
+	simple, err := ic.Simple()
+	require.NoError(t, err)
+	assert.Equal(t, NameMajorThird, simple.Name())
+	assert.Equal(t, DirectionDescending, simple.Direction())
+
+	_, err = (*Chromatic)(nil).Simple()
+	require.ErrorIs(t, err, ErrIntervalUnknown)
+}
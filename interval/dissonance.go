@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+// ErrFrequencyUnknown is returned when the frequency of the note can't be calculated, e.g. the note has no octave.
+var ErrFrequencyUnknown = errors.New("unknown frequency of the note")
+
+// DissonanceModel returns dissonance of two notes sounding together. The greater value means the more dissonant sound.
+type DissonanceModel func(n1, n2 *note.Note) (float64, error)
+
+// HindemithModel returns the model grading intervals between the notes by Hindemith's series of sonances.
+// Dissonance is from 0 for the unison to 1 for the minor second, compound intervals are graded as the simple ones.
+// Notes without octaves are considered as notes of the same octave.
+func HindemithModel() DissonanceModel {
+	return func(n1, n2 *note.Note) (float64, error) {
+		if n1 == nil || n2 == nil {
+			return 0, ErrNoteEmpty
+		}
+
+		halfTones := n2.ChromaticPosition() - n1.ChromaticPosition()
+		if halfTones < 0 {
+			halfTones = -halfTones
+		}
+
+		return SonanceByHalfTones(halftone.HalfTones(halfTones)).Dissonance(), nil //nolint:gosec // notes are within MIDI range
+	}
+}
+
+// Parameters of the Plomp-Levelt dissonance curve as parametrized by W. Sethares.
+const (
+	plompLeveltMaxDissonancePoint = 0.24
+	plompLeveltSlope1             = 0.0207
+	plompLeveltSlope2             = 18.96
+	plompLeveltDecay1             = 3.5
+	plompLeveltDecay2             = 5.75
+
+	// partialsAmplitudeDecay is the ratio of amplitudes of the neighboring partials of a harmonic tone.
+	partialsAmplitudeDecay = 0.88
+)
+
+// plompLeveltCurve returns the Plomp-Levelt dissonance of two pure tones of unit amplitude.
+func plompLeveltCurve(frequency1, frequency2 float64) float64 {
+	lower, difference := math.Min(frequency1, frequency2), math.Abs(frequency2-frequency1)
+	s := plompLeveltMaxDissonancePoint / (plompLeveltSlope1*lower + plompLeveltSlope2)
+
+	return math.Exp(-plompLeveltDecay1*s*difference) - math.Exp(-plompLeveltDecay2*s*difference)
+}
+
+// plompLeveltMaximum returns the maximum of the Plomp-Levelt dissonance curve of two pure tones.
+func plompLeveltMaximum() float64 {
+	x := math.Log(plompLeveltDecay2/plompLeveltDecay1) / (plompLeveltDecay2 - plompLeveltDecay1)
+
+	return math.Exp(-plompLeveltDecay1*x) - math.Exp(-plompLeveltDecay2*x)
+}
+
+// PlompLeveltRoughness returns sensory roughness of two pure tones with the given frequencies and amplitudes
+// by the Plomp-Levelt dissonance curve. The roughness of two pure tones of unit amplitude is from 0 to 1.
+func PlompLeveltRoughness(frequency1, amplitude1, frequency2, amplitude2 float64) float64 {
+	if frequency1 <= 0 || frequency2 <= 0 {
+		return 0
+	}
+
+	return math.Min(amplitude1, amplitude2) * plompLeveltCurve(frequency1, frequency2) / plompLeveltMaximum()
+}
+
+// PlompLeveltModel returns the model calculating sensory roughness of two harmonic tones
+// with the given amount of partials, which frequencies are calculated in the given standard (e.g. 440).
+// Amplitudes of the partials decrease, and the result is normalized by the sum of amplitudes of the partials of one tone,
+// so pure tones (one partial) give dissonance from 0 to 1. Unlike Hindemith's model, the result depends on the register
+// and on the width of the interval, so compound intervals are less dissonant than the simple ones. Notes must have octaves.
+func PlompLeveltModel(standard float64, partials uint8) DissonanceModel {
+	partials = max(partials, 1)
+
+	return func(n1, n2 *note.Note) (float64, error) {
+		if n1 == nil || n2 == nil {
+			return 0, ErrNoteEmpty
+		}
+
+		frequency1, frequency2 := n1.Frequency(standard), n2.Frequency(standard)
+		if frequency1 == 0 || frequency2 == 0 {
+			return 0, fmt.Errorf("notes '%s' and '%s': %w", n1.Name(), n2.Name(), ErrFrequencyUnknown)
+		}
+
+		var roughness, totalAmplitude float64
+		amplitude1 := 1.0
+		for i := 1; i <= int(partials); i++ {
+			totalAmplitude += amplitude1
+			amplitude2 := 1.0
+			for j := 1; j <= int(partials); j++ {
+				roughness += PlompLeveltRoughness(frequency1*float64(i), amplitude1, frequency2*float64(j), amplitude2)
+				amplitude2 *= partialsAmplitudeDecay
+			}
+			amplitude1 *= partialsAmplitudeDecay
+		}
+
+		return roughness / totalAmplitude, nil
+	}
+}
+
+// CombinedModel returns the model mixing Hindemith's grading and Plomp-Levelt roughness of harmonic tones.
+// The weight from 0 to 1 is the share of Hindemith's grading in the result.
+func CombinedModel(hindemithWeight, standard float64, partials uint8) DissonanceModel {
+	hindemith, roughness := HindemithModel(), PlompLeveltModel(standard, partials)
+
+	return func(n1, n2 *note.Note) (float64, error) {
+		hindemithDissonance, err := hindemith(n1, n2)
+		if err != nil {
+			return 0, err
+		}
+
+		roughnessDissonance, err := roughness(n1, n2)
+		if err != nil {
+			return 0, err
+		}
+
+		return hindemithWeight*hindemithDissonance + (1-hindemithWeight)*roughnessDissonance, nil
+	}
+}
+
+// NotesDissonance returns the dissonance of the notes sounding together as the sum of dissonances of all the pairs of the notes.
+func NotesDissonance(notes note.Notes, model DissonanceModel) (float64, error) {
+	var dissonance float64
+	for i := range notes {
+		for j := i + 1; j < len(notes); j++ {
+			pairDissonance, err := model(notes[i], notes[j])
+			if err != nil {
+				return 0, fmt.Errorf("calculate dissonance of notes '%s' and '%s': %w", notes[i].Name(), notes[j].Name(), err)
+			}
+			dissonance += pairDissonance
+		}
+	}
+
+	return dissonance, nil
+}
@@ -0,0 +1,131 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestSonanceByHalfTones(t *testing.T) {
+	for halfTones, want := range map[halftone.HalfTones]Sonance{
+		HalfTones0:  SonancePerfectUnison,
+		HalfTones1:  SonanceMinorSecond,
+		HalfTones7:  SonancePerfectFifth,
+		HalfTones12: SonancePerfectOctave,
+		HalfTones13: SonanceMinorSecond,
+		HalfTones18: SonanceTritone,
+		HalfTones24: SonancePerfectOctave,
+		36:          SonancePerfectOctave,
+		40:          SonanceMajorThird,
+	} {
+		assert.Equal(t, want, SonanceByHalfTones(halfTones), halfTones)
+	}
+
+	for halfTones := HalfTones0; halfTones <= HalfTones24; halfTones++ {
+		ic, err := NewChromatic(halfTones)
+		require.NoError(t, err)
+		assert.Equal(t, SonanceByHalfTones(halfTones), ic.Sonance, halfTones)
+	}
+}
+
+func TestSonance_Dissonance(t *testing.T) {
+	assert.InDelta(t, 1.0, SonanceMinorSecond.Dissonance(), 1e-9)
+	assert.InDelta(t, 0.0, SonancePerfectUnison.Dissonance(), 1e-9)
+	assert.InDelta(t, 1.0/12, SonancePerfectOctave.Dissonance(), 1e-9)
+	assert.InDelta(t, 1.0, Sonance(0).Dissonance(), 1e-9)
+	assert.Less(t, MajorNinth().Dissonance(), MinorNinth().Dissonance())
+
+	assert.Equal(t, []Sonance{SonanceMinorSecond, SonanceMajorSeventh, SonanceTritone}, SonancesWithDissonanceAbove(0.8))
+	assert.Empty(t, SonancesWithDissonanceAbove(1))
+	assert.Len(t, SonancesWithDissonanceAbove(-1), int(SonancePerfectUnison))
+
+	opts := NewIntervalGetOptions(AddFilterByDissonance(0.8))
+	assert.True(t, opts.HasFilterBySonance(SonanceTritone))
+	assert.False(t, opts.HasFilterBySonance(SonanceMajorSecond))
+}
+
+func TestHindemithModel(t *testing.T) {
+	model := HindemithModel()
+
+	dissonance, err := model(note.MustNewNote(note.C), note.MustNewNote(note.DFLAT))
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, dissonance, 1e-9)
+
+	dissonance, err = model(note.MustNewNoteWithOctave(note.G, octave.Number5), note.MustNewNoteWithOctave(note.C, octave.Number4))
+	require.NoError(t, err)
+	assert.InDelta(t, SonancePerfectFifth.Dissonance(), dissonance, 1e-9)
+
+	_, err = model(nil, note.MustNewNote(note.C))
+	require.ErrorIs(t, err, ErrNoteEmpty)
+}
+
+func TestPlompLeveltModel(t *testing.T) {
+	assert.InDelta(t, 0.0, PlompLeveltRoughness(440, 1, 440, 1), 1e-9)
+	assert.InDelta(t, 0.0, PlompLeveltRoughness(0, 1, 440, 1), 1e-9)
+	assert.Greater(t, PlompLeveltRoughness(440, 1, 466, 1), PlompLeveltRoughness(440, 1, 660, 1))
+	assert.LessOrEqual(t, PlompLeveltRoughness(440, 1, 466, 1), 1.0)
+
+	c4 := note.MustNewNoteWithOctave(note.C, octave.Number4)
+	model := PlompLeveltModel(440, 6)
+	dissonanceOf := func(n *note.Note) float64 {
+		dissonance, err := model(c4, n)
+		require.NoError(t, err)
+
+		return dissonance
+	}
+
+	minorSecond := dissonanceOf(note.MustNewNoteWithOctave(note.DFLAT, octave.Number4))
+	majorThird := dissonanceOf(note.MustNewNoteWithOctave(note.E, octave.Number4))
+	fifth := dissonanceOf(note.MustNewNoteWithOctave(note.G, octave.Number4))
+	octaveAbove := dissonanceOf(note.MustNewNoteWithOctave(note.C, octave.Number5))
+	minorNinth := dissonanceOf(note.MustNewNoteWithOctave(note.DFLAT, octave.Number5))
+
+	assert.Greater(t, minorSecond, majorThird)
+	assert.Greater(t, majorThird, fifth)
+	assert.Greater(t, fifth, octaveAbove)
+	assert.Greater(t, minorSecond, minorNinth)
+
+	_, err := model(c4, note.MustNewNote(note.E))
+	require.ErrorIs(t, err, ErrFrequencyUnknown)
+
+	pure, err := PlompLeveltModel(440, 0)(c4, note.MustNewNoteWithOctave(note.DFLAT, octave.Number4))
+	require.NoError(t, err)
+	assert.InDelta(t, PlompLeveltRoughness(c4.Frequency(440), 1, note.MustNewNoteWithOctave(note.DFLAT, octave.Number4).Frequency(440), 1), pure, 1e-9)
+}
+
+func TestNotesDissonance(t *testing.T) {
+	majorTriad := note.Notes{
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+	}
+	cluster := note.Notes{
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+		note.MustNewNoteWithOctave(note.DFLAT, octave.Number4),
+		note.MustNewNoteWithOctave(note.D, octave.Number4),
+	}
+
+	for _, model := range []DissonanceModel{HindemithModel(), PlompLeveltModel(440, 6), CombinedModel(0.5, 440, 6)} {
+		triadDissonance, err := NotesDissonance(majorTriad, model)
+		require.NoError(t, err)
+		clusterDissonance, err := NotesDissonance(cluster, model)
+		require.NoError(t, err)
+		assert.Greater(t, clusterDissonance, triadDissonance)
+	}
+
+	hindemith, err := NotesDissonance(majorTriad, HindemithModel())
+	require.NoError(t, err)
+	assert.InDelta(t, SonanceMajorThird.Dissonance()+SonancePerfectFifth.Dissonance()+SonanceMinorThird.Dissonance(), hindemith, 1e-9)
+
+	dissonance, err := NotesDissonance(majorTriad[:1], HindemithModel())
+	require.NoError(t, err)
+	assert.Zero(t, dissonance)
+
+	_, err = NotesDissonance(note.Notes{majorTriad[0], note.MustNewNote(note.E)}, CombinedModel(0.5, 440, 6))
+	require.ErrorIs(t, err, ErrFrequencyUnknown)
+}
@@ -63,6 +63,12 @@ func AddFilterBySonance(sonances []Sonance) GetOptFunc {
 	}
 }
 
+// AddFilterByDissonance adds a filter for sonances which dissonance is greater than the threshold,
+// so only consonant enough intervals are left.
+func AddFilterByDissonance(threshold float64) GetOptFunc {
+	return AddFilterBySonance(SonancesWithDissonanceAbove(threshold))
+}
+
 // HasFilterByDegreeCharacteristicName checks if a degree characteristic name filter exists.
 func (o *FilteringOptions) HasFilterByDegreeCharacteristicName(dcn degree.CharacteristicName) bool {
 	_, ok := o.FilterByDegreeCharacteristicName[dcn]
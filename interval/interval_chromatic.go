@@ -18,11 +18,15 @@ type nameExtended struct {
 	shortName Name
 }
 
-// Chromatic is the interval defined just by halftone, without degrees.
+// Chromatic is the interval defined by halftone.
+// It also keeps amount of degrees between the notes to distinguish enharmonically equal intervals,
+// and the direction of the interval.
 type Chromatic struct {
 	Sonance
 	names     *nameExtended
 	halfTones halftone.HalfTones
+	degrees   degree.Number
+	direction Direction
 }
 
 // Name returns interval's name.
@@ -52,6 +56,24 @@ func (ic *Chromatic) HalfTones() halftone.HalfTones {
 	return ic.halfTones
 }
 
+// Degrees returns amount of degrees between the notes of the interval: 0 for unison, 1 for second, 7 for octave etc.
+func (ic *Chromatic) Degrees() degree.Number {
+	if ic == nil {
+		return 0
+	}
+
+	return ic.degrees
+}
+
+// Direction returns direction of the interval.
+func (ic *Chromatic) Direction() Direction {
+	if ic == nil {
+		return DirectionAscending
+	}
+
+	return ic.direction
+}
+
 // NewChromatic creates interval just by halftone between the notes
 // Such interval is known as chromatic  interval or acoustic interval.
 func NewChromatic(halfTones halftone.HalfTones) (*Chromatic, error) {
@@ -227,7 +249,8 @@ func MustNewIntervalByHalfTonesAndDegrees(halfTones halftone.HalfTones, degrees
 	return chromaticInterval
 }
 
-// NewIntervalByName returns new interval by its name.
+// NewIntervalByName returns new interval by its name or short name.
+// Names which are not constants of the package are parsed as shorthand notation, see Parse.
 func NewIntervalByName(intervalName Name) (*Chromatic, error) {
 	switch intervalName {
 	// Chromatic intervals
@@ -258,51 +281,82 @@ func NewIntervalByName(intervalName Name) (*Chromatic, error) {
 	case NamePerfectOctave, NamePerfectOctaveShort:
 		return PerfectOctave(), nil
 
+	// Compound intervals
+	case NameMinorNinth, NameMinorNinthShort:
+		return MinorNinth(), nil
+	case NameMajorNinth, NameMajorNinthShort:
+		return MajorNinth(), nil
+	case NameMinorTenth, NameMinorTenthShort:
+		return MinorTenth(), nil
+	case NameMajorTenth, NameMajorTenthShort:
+		return MajorTenth(), nil
+	case NamePerfectEleventh, NamePerfectEleventhShort:
+		return PerfectEleventh(), nil
+	case NameOctaveWithTritone, NameOctaveWithTritoneShort:
+		return OctaveWithTritone(), nil
+	case NamePerfectTwelfth, NamePerfectTwelfthShort:
+		return PerfectTwelfth(), nil
+	case NameMinorThirteenth, NameMinorThirteenthShort:
+		return MinorThirteenth(), nil
+	case NameMajorThirteenth, NameMajorThirteenthShort:
+		return MajorThirteenth(), nil
+	case NameMinorFourteenth, NameMinorFourteenthShort:
+		return MinorFourteenth(), nil
+	case NameMajorFourteenth, NameMajorFourteenthShort:
+		return MajorFourteenth(), nil
+	case NamePerfectFifteenth, NamePerfectFifteenthShort:
+		return PerfectFifteenth(), nil
+
 	// Diatonic intervals
 	case NameDiminishedSecond, NameDiminishedSecondShort:
 		return DiminishedSecond(), nil
 
 	case NameAugmentedUnison, NameAugmentedUnisonShort:
-		return DiminishedSecond(), nil
+		return AugmentedUnison(), nil
 
 	case NameDiminishedThird, NameDiminishedThirdShort:
-		return DiminishedSecond(), nil
+		return DiminishedThird(), nil
 
 	case NameAugmentedSecond, NameAugmentedSecondShort:
-		return DiminishedSecond(), nil
+		return AugmentedSecond(), nil
 
 	case NameDiminishedFourth, NameDiminishedFourthShort:
-		return DiminishedSecond(), nil
+		return DiminishedFourth(), nil
 
 	case NameAugmentedThird, NameAugmentedThirdShort:
-		return DiminishedSecond(), nil
+		return AugmentedThird(), nil
 
 	case NameDiminishedFifth, NameDiminishedFifthShort:
-		return DiminishedSecond(), nil
+		return DiminishedFifth(), nil
 
 	case NameAugmentedFourth, NameAugmentedFourthShort:
-		return DiminishedSecond(), nil
+		return AugmentedFourth(), nil
 
 	case NameDiminishedSixth, NameDiminishedSixthShort:
-		return DiminishedSecond(), nil
+		return DiminishedSixth(), nil
 
 	case NameAugmentedFifth, NameAugmentedFifthShort:
-		return DiminishedSecond(), nil
+		return AugmentedFifth(), nil
 
 	case NameDiminishedSeventh, NameDiminishedSeventhShort:
-		return DiminishedSecond(), nil
+		return DiminishedSeventh(), nil
 
 	case NameAugmentedSixth, NameAugmentedSixthShort:
-		return DiminishedSecond(), nil
+		return AugmentedSixth(), nil
 
 	case NameDiminishedOctave, NameDiminishedOctaveShort:
-		return DiminishedSecond(), nil
+		return DiminishedOctave(), nil
 
 	case NameAugmentedSeventh, NameAugmentedSeventhShort:
-		return DiminishedSecond(), nil
+		return AugmentedSeventh(), nil
 	}
 
-	return nil, ErrIntervalUnknown
+	ic, err := Parse(string(intervalName))
+	if err != nil {
+		return nil, err
+	}
+
+	return ic, nil
 }
 
 // MakeNoteByName creates new note by the given interval name.
@@ -103,3 +103,156 @@ func ExampleMakeDegreeByName() {
 	)
 	// Output: Second Diatonic Degree Number: 2, halftone from prime: 6, Note name: F#
 }
+
+// Interval between two notes is spelled by their letter names, so the same halftones may be named differently.
+func ExampleBetween() {
+	augmentedSecond, err := interval.Between(note.MustNewNote(note.C), note.MustNewNote(note.DSHARP))
+	if err != nil {
+		panic(err)
+	}
+
+	minorThird, err := interval.Between(note.MustNewNote(note.C), note.MustNewNote(note.EFLAT))
+	if err != nil {
+		panic(err)
+	}
+
+	compound, err := interval.Between(note.MustNewNoteWithOctave(note.E, octave.Number5), note.MustNewNoteWithOctave(note.C, octave.Number4))
+	if err != nil {
+		panic(err)
+	}
+
+	simple, err := compound.Simple()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(augmentedSecond.ShortName(), minorThird.ShortName())
+	fmt.Println(compound.Name(), compound.Direction(), simple.Name())
+	// Output:
+	// A2 m3
+	// MajorTenth descending MajorThird
+}
+
+// Intervals can be added, subtracted, inverted and compounded keeping their spelling.
+func ExampleChromatic_Add() {
+	fifth, err := interval.MajorThird().Add(interval.MinorThird())
+	if err != nil {
+		panic(err)
+	}
+
+	augmentedFifth, err := interval.MajorThird().Add(interval.MajorThird())
+	if err != nil {
+		panic(err)
+	}
+
+	inverted, err := interval.AugmentedFourth().Invert()
+	if err != nil {
+		panic(err)
+	}
+
+	ninth, err := interval.MajorSecond().Compound(1)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(fifth.ShortName(), augmentedFifth.ShortName(), inverted.ShortName(), ninth.ShortName())
+	// Output: P5 A5 d5 M9
+}
+
+// Notes are transposed by interval keeping their spelling, or to another key with simplified spelling.
+func ExampleTransposeNotes() {
+	notes := note.MustNewNotesFromNoteNames(note.C, note.E, note.G)
+
+	sharps, err := interval.TransposeNotes(notes, interval.AugmentedUnison(), interval.DirectionAscending)
+	if err != nil {
+		panic(err)
+	}
+
+	flats, err := interval.TransposeNotes(notes, interval.MinorSecond(), interval.DirectionAscending)
+	if err != nil {
+		panic(err)
+	}
+
+	simplified, err := interval.TransposeNotesToKey(notes, note.MustNewNote(note.C), note.MustNewNote(note.GSHARP))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(sharps, flats, simplified)
+	// Output: [C# E# G#] [Db F Ab] [G# C D#]
+}
+
+// Intervals can be parsed from shorthand and chord-symbol notations and formatted back.
+func ExampleParse() {
+	for _, shorthand := range []string{"m3", "A4", "+5", "b9", "#11", "13", "-P4"} {
+		ic, err := interval.Parse(shorthand)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(shorthand, ic.Name(), ic.Direction(), ic.Shorthand(), ic.AlterationShorthand())
+	}
+	// Output:
+	// m3 MinorThird ascending m3 b3
+	// A4 AugmentedFourth ascending A4 #4
+	// +5 AugmentedFifth ascending A5 #5
+	// b9 MinorNinth ascending m9 b9
+	// #11 AugmentedEleventh ascending A11 #11
+	// 13 MajorThirteenth ascending M13 13
+	// -P4 PerfectFourth descending -P4 -4
+}
+
+// Intervals of the equal temperament can be compared with their just ratios, and arbitrary ratios can be named.
+func ExampleChromatic_JustRatio() {
+	for _, shorthand := range []string{"M3", "P5", "m7"} {
+		ic := interval.MustParse(shorthand)
+
+		ratio, err := ic.JustRatio()
+		if err != nil {
+			panic(err)
+		}
+
+		deviation, err := ic.JustDeviation()
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s %.0f %s %.2f\n", shorthand, ic.Cents(), ratio, deviation)
+	}
+
+	ic, deviation, err := interval.NearestByRatio(7.0 / 4)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("7/4 %s %.2f\n", ic.Name(), deviation)
+	// Output:
+	// M3 400 5/4 13.69
+	// P5 700 3/2 -1.96
+	// m7 1000 9/5 -17.60
+	// 7/4 MinorSeventh -31.17
+}
+
+// Interval names can be translated into German, Italian, Spanish and Russian, and parsed back.
+func ExampleName_Format() {
+	for _, locale := range []note.Locale{note.LocaleGerman, note.LocaleItalian, note.LocaleRussian} {
+		formatted, err := interval.NameMajorThird.Format(locale)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(locale, formatted)
+	}
+
+	name, err := interval.ParseName("чистая квинта", note.LocaleRussian)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(name)
+	// Output:
+	// de große Terz
+	// it terza maggiore
+	// ru большая терция
+	// PerfectFifth
+}
@@ -65,6 +65,19 @@ func TestChromaticInterval_HalfTones(t *testing.T) {
 	}
 }
 
+func TestChromaticInterval_CompoundHalfTones(t *testing.T) {
+	for halfTones := HalfTones13; halfTones <= HalfTones24; halfTones++ {
+		interval, err := NewChromatic(halfTones)
+		require.NoError(t, err)
+		assert.Equal(t, halfTones, interval.HalfTones(), interval.Name())
+
+		simple, err := interval.Simple()
+		require.NoError(t, err)
+		assert.Equal(t, halfTones-halftone.HalfTonesInOctave, simple.HalfTones(), interval.Name())
+		assert.Equal(t, interval.Degrees()-DegreesInOctave, simple.Degrees(), interval.Name())
+	}
+}
+
 func TestMakeNoteByName(t *testing.T) {
 	firstNote := note.MustNewNote(note.C)
 	n, err := MakeNoteByName(firstNote, NameTritone)
@@ -40,6 +40,7 @@ func TestNewIntervalByDegrees(t *testing.T) {
 					shortName: NameMinorSecondShort,
 				},
 				halfTones: 1,
+				degrees:   1,
 			}, nil),
 		makeTestCase(newDegreeWithNumAndHalfTones(1, 0), newDegreeWithNumAndHalfTones(2, 2),
 			&Chromatic{
@@ -49,6 +50,7 @@ func TestNewIntervalByDegrees(t *testing.T) {
 					shortName: NameMajorSecondShort,
 				},
 				halfTones: 2,
+				degrees:   1,
 			}, nil),
 		makeTestCase(newDegreeWithNumAndHalfTones(1, 0), newDegreeWithNumAndHalfTones(3, 2),
 			&Chromatic{
@@ -58,6 +60,7 @@ func TestNewIntervalByDegrees(t *testing.T) {
 					shortName: NameDiminishedThirdShort,
 				},
 				halfTones: 2,
+				degrees:   2,
 			}, nil),
 		makeTestCase(newDegreeWithNumAndHalfTones(1, 2), newDegreeWithNumAndHalfTones(2, 4),
 			&Chromatic{
@@ -67,6 +70,7 @@ func TestNewIntervalByDegrees(t *testing.T) {
 					shortName: NameMajorSecondShort,
 				},
 				halfTones: 2,
+				degrees:   1,
 			}, nil),
 		makeTestCase(newDegreeWithNumAndHalfTones(3, 2), newDegreeWithNumAndHalfTones(2, 4),
 			&Chromatic{
@@ -76,6 +80,7 @@ func TestNewIntervalByDegrees(t *testing.T) {
 					shortName: NameMajorSecondShort,
 				},
 				halfTones: 2,
+				degrees:   1,
 			}, ErrIntervalUnknown),
 	}
 
@@ -0,0 +1,194 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-muse/muse/halftone"
+)
+
+const (
+	// Quarter-tone intervals.
+
+	NameSemiAugmentedUnison  = Name("SemiAugmentedUnison")
+	NameNeutralSecond        = Name("NeutralSecond")
+	NameSemiAugmentedSecond  = Name("SemiAugmentedSecond")
+	NameNeutralThird         = Name("NeutralThird")
+	NameSemiAugmentedThird   = Name("SemiAugmentedThird")
+	NameSemiAugmentedFourth  = Name("SemiAugmentedFourth")
+	NameSemiDiminishedFifth  = Name("SemiDiminishedFifth")
+	NameSemiAugmentedFifth   = Name("SemiAugmentedFifth")
+	NameNeutralSixth         = Name("NeutralSixth")
+	NameSemiAugmentedSixth   = Name("SemiAugmentedSixth")
+	NameNeutralSeventh       = Name("NeutralSeventh")
+	NameSemiDiminishedOctave = Name("SemiDiminishedOctave")
+
+	NameSemiAugmentedUnisonShort  = Name("sA1")
+	NameNeutralSecondShort        = Name("n2")
+	NameSemiAugmentedSecondShort  = Name("sA2")
+	NameNeutralThirdShort         = Name("n3")
+	NameSemiAugmentedThirdShort   = Name("sA3")
+	NameSemiAugmentedFourthShort  = Name("sA4")
+	NameSemiDiminishedFifthShort  = Name("sd5")
+	NameSemiAugmentedFifthShort   = Name("sA5")
+	NameNeutralSixthShort         = Name("n6")
+	NameSemiAugmentedSixthShort   = Name("sA6")
+	NameNeutralSeventhShort       = Name("n7")
+	NameSemiDiminishedOctaveShort = Name("sd8")
+)
+
+// getQuarterToneNames returns names of the intervals lying between the halftones of an octave.
+// The key is amount of quarter tones in the interval.
+func getQuarterToneNames() map[halftone.HalfTones]*nameExtended {
+	return map[halftone.HalfTones]*nameExtended{
+		1:  {name: NameSemiAugmentedUnison, shortName: NameSemiAugmentedUnisonShort},
+		3:  {name: NameNeutralSecond, shortName: NameNeutralSecondShort},
+		5:  {name: NameSemiAugmentedSecond, shortName: NameSemiAugmentedSecondShort},
+		7:  {name: NameNeutralThird, shortName: NameNeutralThirdShort},
+		9:  {name: NameSemiAugmentedThird, shortName: NameSemiAugmentedThirdShort},
+		11: {name: NameSemiAugmentedFourth, shortName: NameSemiAugmentedFourthShort},
+		13: {name: NameSemiDiminishedFifth, shortName: NameSemiDiminishedFifthShort},
+		15: {name: NameSemiAugmentedFifth, shortName: NameSemiAugmentedFifthShort},
+		17: {name: NameNeutralSixth, shortName: NameNeutralSixthShort},
+		19: {name: NameSemiAugmentedSixth, shortName: NameSemiAugmentedSixthShort},
+		21: {name: NameNeutralSeventh, shortName: NameNeutralSeventhShort},
+		23: {name: NameSemiDiminishedOctave, shortName: NameSemiDiminishedOctaveShort},
+	}
+}
+
+// EDO is the interval defined by amount of steps of an equal division of the octave.
+type EDO struct {
+	division halftone.EDO
+	steps    halftone.HalfTones
+}
+
+// NewEDO creates interval by amount of steps of the given division of the octave.
+func NewEDO(division halftone.EDO, steps halftone.HalfTones) (*EDO, error) {
+	if err := division.Validate(); err != nil {
+		return nil, fmt.Errorf("create interval of %d steps: %w", steps, err)
+	}
+
+	return &EDO{division: division, steps: steps}, nil
+}
+
+// MustNewEDO creates interval as NewEDO does, if you are confident in the correctness of the division.
+func MustNewEDO(division halftone.EDO, steps halftone.HalfTones) *EDO {
+	ie, err := NewEDO(division, steps)
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
+
+// Division returns the division of the octave the interval belongs to.
+func (ie *EDO) Division() halftone.EDO {
+	if ie == nil {
+		return 0
+	}
+
+	return ie.division
+}
+
+// Steps returns amount of steps of the division in the interval.
+func (ie *EDO) Steps() halftone.HalfTones {
+	if ie == nil {
+		return 0
+	}
+
+	return ie.steps
+}
+
+// Cents returns the size of the interval in cents.
+func (ie *EDO) Cents() float64 {
+	if ie == nil {
+		return 0
+	}
+
+	return ie.division.Cents(int(ie.steps))
+}
+
+// Ratio returns the frequency ratio of the interval.
+func (ie *EDO) Ratio() float64 {
+	if ie == nil {
+		return 1
+	}
+
+	return ie.division.Ratio(int(ie.steps))
+}
+
+// Nearest returns the closest chromatic interval of the twelve-tone equal temperament
+// and the deviation of the interval from it in cents.
+// It returns nil if the interval is wider than two octaves.
+func (ie *EDO) Nearest() (*Chromatic, float64) {
+	if ie == nil {
+		return nil, 0
+	}
+
+	const centsInHalfTone = 100.0
+	halfTones := math.Round(ie.Cents() / centsInHalfTone)
+	if halfTones > float64(HalfTones24) {
+		return nil, 0
+	}
+
+	ic, err := NewChromatic(halftone.HalfTones(halfTones))
+	if err != nil {
+		return nil, 0
+	}
+
+	return ic, ie.Cents() - halfTones*centsInHalfTone
+}
+
+// names returns names of the interval if it coincides with a halftone or a quarter tone.
+func (ie *EDO) names() *nameExtended {
+	if ie == nil || ie.division == 0 {
+		return nil
+	}
+
+	steps, division := int(ie.steps), int(ie.division)
+
+	if halfTones := steps * int(halftone.EDO12); halfTones%division == 0 {
+		ic, err := NewChromatic(halftone.HalfTones(halfTones / division)) //nolint:gosec // checked by NewChromatic
+		if err != nil {
+			return nil
+		}
+
+		return ic.names
+	}
+
+	const quarterTonesInOctave = 2 * int(halftone.EDO12)
+	if quarterTones := steps * quarterTonesInOctave; quarterTones%division == 0 {
+		return getQuarterToneNames()[halftone.HalfTones(quarterTones/division)] //nolint:gosec // quarter tones within octave
+	}
+
+	return nil
+}
+
+// Name returns the name of the interval if it coincides with a chromatic interval or a quarter-tone interval.
+// Otherwise, it returns empty name.
+func (ie *EDO) Name() Name {
+	if names := ie.names(); names != nil {
+		return names.name
+	}
+
+	return ""
+}
+
+// ShortName returns the short name of the interval if it coincides with a chromatic interval or a quarter-tone interval.
+// Otherwise, it returns empty name.
+func (ie *EDO) ShortName() Name {
+	if names := ie.names(); names != nil {
+		return names.shortName
+	}
+
+	return ""
+}
+
+// String returns the interval in the Scala notation: steps\division.
+func (ie *EDO) String() string {
+	if ie == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d\\%d", ie.steps, ie.division)
+}
@@ -0,0 +1,82 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+)
+
+func TestIntervalEDO(t *testing.T) {
+	testCases := []struct {
+		name      string
+		division  halftone.EDO
+		steps     halftone.HalfTones
+		cents     float64
+		fullName  Name
+		shortName Name
+		nearest   Name
+		deviation float64
+		str       string
+	}{
+		{
+			name: "fifth in 12-EDO", division: halftone.EDO12, steps: 7, cents: 700,
+			fullName: NamePerfectFifth, shortName: NamePerfectFifthShort, nearest: NamePerfectFifth, deviation: 0, str: "7\\12",
+		},
+		{
+			name: "neutral third in 24-EDO", division: halftone.EDO24, steps: 7, cents: 350,
+			fullName: NameNeutralThird, shortName: NameNeutralThirdShort, nearest: NameMajorThird, deviation: -50, str: "7\\24",
+		},
+		{
+			name: "semi-diminished octave in 24-EDO", division: halftone.EDO24, steps: 23, cents: 1150,
+			fullName: NameSemiDiminishedOctave, shortName: NameSemiDiminishedOctaveShort, nearest: NamePerfectOctave, deviation: -50, str: "23\\24",
+		},
+		{
+			name: "major ninth in 24-EDO", division: halftone.EDO24, steps: 28, cents: 1400,
+			fullName: NameMajorNinth, shortName: NameMajorNinthShort, nearest: NameMajorNinth, deviation: 0, str: "28\\24",
+		},
+		{
+			name: "fifth in 19-EDO", division: halftone.EDO19, steps: 11, cents: 1200.0 * 11 / 19,
+			fullName: "", shortName: "", nearest: NamePerfectFifth, deviation: 1200.0*11/19 - 700, str: "11\\19",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ie, err := NewEDO(testCase.division, testCase.steps)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.division, ie.Division())
+			assert.Equal(t, testCase.steps, ie.Steps())
+			assert.InDelta(t, testCase.cents, ie.Cents(), 0.000001)
+			assert.Equal(t, testCase.fullName, ie.Name())
+			assert.Equal(t, testCase.shortName, ie.ShortName())
+			assert.Equal(t, testCase.str, ie.String())
+
+			nearest, deviation := ie.Nearest()
+			assert.Equal(t, testCase.nearest, nearest.Name())
+			assert.InDelta(t, testCase.deviation, deviation, 0.000001)
+		})
+	}
+}
+
+func TestIntervalEDOEdgeCases(t *testing.T) {
+	_, err := NewEDO(0, 1)
+	require.ErrorIs(t, err, halftone.ErrInvalidEDO)
+
+	assert.Panics(t, func() { MustNewEDO(0, 1) })
+
+	nearest, _ := MustNewEDO(halftone.EDO12, 25).Nearest()
+	assert.Nil(t, nearest)
+
+	assert.InDelta(t, 2.0, MustNewEDO(halftone.EDO31, 31).Ratio(), 0.000001)
+
+	var ie *EDO
+	assert.Zero(t, ie.Division())
+	assert.Zero(t, ie.Steps())
+	assert.Zero(t, ie.Cents())
+	assert.Empty(t, ie.Name())
+	assert.Empty(t, ie.String())
+}
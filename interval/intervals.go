@@ -32,7 +32,7 @@ const (
 	NameMajorThirteenth   = Name("MajorThirteenth")
 	NameMinorFourteenth   = Name("MinorFourteenth")
 	NameMajorFourteenth   = Name("MajorFourteenth")
-	NamePerfectFifteenth  = Name("PerfectFifteenth ") // Double octave
+	NamePerfectFifteenth  = Name("PerfectFifteenth") // Double octave
 
 	NamePerfectUnisonShort = Name("P1")
 	NameMinorSecondShort   = Name("m2")
@@ -164,6 +164,7 @@ func PerfectUnison() *Chromatic {
 			shortName: NamePerfectUnisonShort,
 		},
 		halfTones: HalfTones0,
+		degrees:   0,
 	}
 }
 
@@ -175,6 +176,7 @@ func MinorSecond() *Chromatic {
 			shortName: NameMinorSecondShort,
 		},
 		halfTones: HalfTones1,
+		degrees:   1,
 	}
 }
 
@@ -186,6 +188,7 @@ func MajorSecond() *Chromatic {
 			shortName: NameMajorSecondShort,
 		},
 		halfTones: HalfTones2,
+		degrees:   1,
 	}
 }
 
@@ -197,6 +200,7 @@ func MinorThird() *Chromatic {
 			shortName: NameMinorThirdShort,
 		},
 		halfTones: HalfTones3,
+		degrees:   2,
 	}
 }
 
@@ -208,6 +212,7 @@ func MajorThird() *Chromatic {
 			shortName: NameMajorThirdShort,
 		},
 		halfTones: HalfTones4,
+		degrees:   2,
 	}
 }
 
@@ -219,6 +224,7 @@ func PerfectFourth() *Chromatic {
 			shortName: NamePerfectFourthShort,
 		},
 		halfTones: HalfTones5,
+		degrees:   3,
 	}
 }
 
@@ -230,6 +236,7 @@ func Tritone() *Chromatic {
 			shortName: NameTritoneShort,
 		},
 		halfTones: HalfTones6,
+		degrees:   3,
 	}
 }
 
@@ -241,6 +248,7 @@ func PerfectFifth() *Chromatic {
 			shortName: NamePerfectFifthShort,
 		},
 		halfTones: HalfTones7,
+		degrees:   4,
 	}
 }
 
@@ -252,6 +260,7 @@ func MinorSixth() *Chromatic {
 			shortName: NameMinorSixthShort,
 		},
 		halfTones: HalfTones8,
+		degrees:   5,
 	}
 }
 
@@ -263,6 +272,7 @@ func MajorSixth() *Chromatic {
 			shortName: NameMajorSixthShort,
 		},
 		halfTones: HalfTones9,
+		degrees:   5,
 	}
 }
 
@@ -274,6 +284,7 @@ func MinorSeventh() *Chromatic {
 			shortName: NameMinorSeventhShort,
 		},
 		halfTones: HalfTones10,
+		degrees:   6,
 	}
 }
 
@@ -285,6 +296,7 @@ func MajorSeventh() *Chromatic {
 			shortName: NameMajorSeventhShort,
 		},
 		halfTones: HalfTones11,
+		degrees:   6,
 	}
 }
 
@@ -296,138 +308,151 @@ func PerfectOctave() *Chromatic {
 			shortName: NamePerfectOctaveShort,
 		},
 		halfTones: HalfTones12,
+		degrees:   7,
 	}
 }
 
 func MinorNinth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMinorSecond,
 		names: &nameExtended{
 			name:      NameMinorNinth,
 			shortName: NameMinorNinthShort,
 		},
 		halfTones: HalfTones13,
+		degrees:   8,
 	}
 }
 
 func MajorNinth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMajorSecond,
 		names: &nameExtended{
 			name:      NameMajorNinth,
 			shortName: NameMajorNinthShort,
 		},
 		halfTones: HalfTones14,
+		degrees:   8,
 	}
 }
 
 func MinorTenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMinorThird,
 		names: &nameExtended{
 			name:      NameMinorTenth,
 			shortName: NameMinorTenthShort,
 		},
 		halfTones: HalfTones15,
+		degrees:   9,
 	}
 }
 
 func MajorTenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMajorThird,
 		names: &nameExtended{
 			name:      NameMajorTenth,
 			shortName: NameMajorTenthShort,
 		},
 		halfTones: HalfTones16,
+		degrees:   9,
 	}
 }
 
 func PerfectEleventh() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonancePerfectFourth,
 		names: &nameExtended{
 			name:      NamePerfectEleventh,
 			shortName: NamePerfectEleventhShort,
 		},
 		halfTones: HalfTones17,
+		degrees:   10,
 	}
 }
 
 func OctaveWithTritone() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceTritone,
 		names: &nameExtended{
 			name:      NameOctaveWithTritone,
 			shortName: NameOctaveWithTritoneShort,
 		},
-		halfTones: HalfTones17,
+		halfTones: HalfTones18,
+		degrees:   10,
 	}
 }
 
 func PerfectTwelfth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonancePerfectFifth,
 		names: &nameExtended{
 			name:      NamePerfectTwelfth,
 			shortName: NamePerfectTwelfthShort,
 		},
 		halfTones: HalfTones19,
+		degrees:   11,
 	}
 }
 
 func MinorThirteenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMinorSixth,
 		names: &nameExtended{
 			name:      NameMinorThirteenth,
 			shortName: NameMinorThirteenthShort,
 		},
 		halfTones: HalfTones20,
+		degrees:   12,
 	}
 }
 
 func MajorThirteenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMajorSixth,
 		names: &nameExtended{
 			name:      NameMajorThirteenth,
 			shortName: NameMajorThirteenthShort,
 		},
 		halfTones: HalfTones21,
+		degrees:   12,
 	}
 }
 
 func MinorFourteenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMinorSeventh,
 		names: &nameExtended{
 			name:      NameMinorFourteenth,
 			shortName: NameMinorFourteenthShort,
 		},
 		halfTones: HalfTones22,
+		degrees:   13,
 	}
 }
 
 func MajorFourteenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonanceMajorSeventh,
 		names: &nameExtended{
 			name:      NameMajorFourteenth,
 			shortName: NameMajorFourteenthShort,
 		},
 		halfTones: HalfTones23,
+		degrees:   13,
 	}
 }
 
 func PerfectFifteenth() *Chromatic {
 	return &Chromatic{
-		Sonance: 0,
+		Sonance: SonancePerfectOctave,
 		names: &nameExtended{
 			name:      NamePerfectFifteenth,
 			shortName: NamePerfectFifteenthShort,
 		},
 		halfTones: HalfTones24,
+		degrees:   14,
 	}
 }
 
@@ -441,6 +466,7 @@ func DiminishedSecond() *Chromatic {
 			shortName: NameDiminishedSecondShort,
 		},
 		halfTones: HalfTones0,
+		degrees:   1,
 	}
 }
 
@@ -452,6 +478,7 @@ func AugmentedUnison() *Chromatic {
 			shortName: NameAugmentedUnisonShort,
 		},
 		halfTones: HalfTones1,
+		degrees:   0,
 	}
 }
 
@@ -463,6 +490,7 @@ func DiminishedThird() *Chromatic {
 			shortName: NameDiminishedThirdShort,
 		},
 		halfTones: HalfTones2,
+		degrees:   2,
 	}
 }
 
@@ -474,6 +502,7 @@ func AugmentedSecond() *Chromatic {
 			shortName: NameAugmentedSecondShort,
 		},
 		halfTones: HalfTones3,
+		degrees:   1,
 	}
 }
 
@@ -485,6 +514,7 @@ func DiminishedFourth() *Chromatic {
 			shortName: NameDiminishedFourthShort,
 		},
 		halfTones: HalfTones4,
+		degrees:   3,
 	}
 }
 
@@ -496,6 +526,7 @@ func AugmentedThird() *Chromatic {
 			shortName: NameAugmentedThirdShort,
 		},
 		halfTones: HalfTones5,
+		degrees:   2,
 	}
 }
 
@@ -507,6 +538,7 @@ func DiminishedFifth() *Chromatic {
 			shortName: NameDiminishedFifthShort,
 		},
 		halfTones: HalfTones6,
+		degrees:   4,
 	}
 }
 
@@ -518,6 +550,7 @@ func AugmentedFourth() *Chromatic {
 			shortName: NameAugmentedFourthShort,
 		},
 		halfTones: HalfTones6,
+		degrees:   3,
 	}
 }
 
@@ -529,6 +562,7 @@ func DiminishedSixth() *Chromatic {
 			shortName: NameDiminishedSixthShort,
 		},
 		halfTones: HalfTones7,
+		degrees:   5,
 	}
 }
 
@@ -540,6 +574,7 @@ func AugmentedFifth() *Chromatic {
 			shortName: NameAugmentedFifthShort,
 		},
 		halfTones: HalfTones8,
+		degrees:   4,
 	}
 }
 
@@ -551,6 +586,7 @@ func DiminishedSeventh() *Chromatic {
 			shortName: NameDiminishedSeventhShort,
 		},
 		halfTones: HalfTones9,
+		degrees:   6,
 	}
 }
 
@@ -562,6 +598,7 @@ func AugmentedSixth() *Chromatic {
 			shortName: NameAugmentedSixthShort,
 		},
 		halfTones: HalfTones10,
+		degrees:   5,
 	}
 }
 
@@ -573,6 +610,7 @@ func DiminishedOctave() *Chromatic {
 			shortName: NameDiminishedOctaveShort,
 		},
 		halfTones: HalfTones11,
+		degrees:   7,
 	}
 }
 
@@ -584,5 +622,6 @@ func AugmentedSeventh() *Chromatic {
 			shortName: NameAugmentedSeventhShort,
 		},
 		halfTones: HalfTones12,
+		degrees:   6,
 	}
 }
@@ -0,0 +1,217 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/go-muse/muse/common/fraction"
+	"github.com/go-muse/muse/halftone"
+)
+
+// centsInOctave is amount of cents in octave.
+const centsInOctave = 1200.0
+
+// ErrJustRatioUnknown is returned when there is no just ratio for the interval.
+var ErrJustRatioUnknown = errors.New("unknown just ratio of the interval")
+
+// JustRatio is the frequency ratio of an interval in just intonation.
+type JustRatio struct {
+	fraction.Fraction
+}
+
+// NewJustRatio creates just ratio from its numerator and denominator reduced to lowest terms.
+func NewJustRatio(numerator, denominator uint64) JustRatio {
+	divisor := gcd(numerator, denominator)
+	if divisor == 0 {
+		return JustRatio{Fraction: *fraction.New(numerator, denominator)}
+	}
+
+	return JustRatio{Fraction: *fraction.New(numerator/divisor, denominator/divisor)}
+}
+
+// gcd returns the greatest common divisor of the numbers.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// Float returns the ratio as a floating point number.
+func (jr JustRatio) Float() float64 {
+	if jr.Denominator == 0 {
+		return 0
+	}
+
+	return float64(jr.Numerator) / float64(jr.Denominator)
+}
+
+// Cents returns the size of the ratio in cents.
+func (jr JustRatio) Cents() float64 {
+	return RatioToCents(jr.Float())
+}
+
+// Limit returns the prime limit of the ratio: the greatest prime factor of its numerator and denominator.
+func (jr JustRatio) Limit() uint64 {
+	limit := uint64(1)
+	for _, n := range []uint64{jr.Numerator, jr.Denominator} {
+		for factor := uint64(2); n > 1 && factor*factor <= n; factor++ {
+			for n%factor == 0 {
+				limit = max(limit, factor)
+				n /= factor
+			}
+		}
+		if n > 1 {
+			limit = max(limit, n)
+		}
+	}
+
+	return limit
+}
+
+// String returns the ratio in the form "5/4".
+func (jr JustRatio) String() string {
+	return fmt.Sprintf("%d/%d", jr.Numerator, jr.Denominator)
+}
+
+// RatioToCents returns the size of the frequency ratio in cents.
+func RatioToCents(ratio float64) float64 {
+	return centsInOctave * math.Log2(ratio)
+}
+
+// getJustRatios returns just ratios of the simple intervals by their shorthand notation.
+// The first ratio is the canonical 5-limit one, the others are alternatives, mostly 7-limit ones.
+func getJustRatios() map[Name][]JustRatio {
+	return map[Name][]JustRatio{
+		"P1": {NewJustRatio(1, 1)},
+		"A1": {NewJustRatio(25, 24)},
+		"d2": {NewJustRatio(128, 125)},
+		"m2": {NewJustRatio(16, 15)},
+		"M2": {NewJustRatio(9, 8), NewJustRatio(8, 7)},
+		"A2": {NewJustRatio(75, 64), NewJustRatio(7, 6)},
+		"d3": {NewJustRatio(256, 225), NewJustRatio(8, 7)},
+		"m3": {NewJustRatio(6, 5), NewJustRatio(7, 6)},
+		"M3": {NewJustRatio(5, 4), NewJustRatio(9, 7)},
+		"A3": {NewJustRatio(125, 96), NewJustRatio(9, 7)},
+		"d4": {NewJustRatio(32, 25), NewJustRatio(14, 11)},
+		"P4": {NewJustRatio(4, 3)},
+		"A4": {NewJustRatio(45, 32), NewJustRatio(7, 5)},
+		"d5": {NewJustRatio(64, 45), NewJustRatio(10, 7)},
+		"P5": {NewJustRatio(3, 2)},
+		"A5": {NewJustRatio(25, 16), NewJustRatio(14, 9)},
+		"d6": {NewJustRatio(192, 125), NewJustRatio(14, 9)},
+		"m6": {NewJustRatio(8, 5), NewJustRatio(14, 9)},
+		"M6": {NewJustRatio(5, 3), NewJustRatio(12, 7)},
+		"A6": {NewJustRatio(125, 72), NewJustRatio(7, 4)},
+		"d7": {NewJustRatio(128, 75), NewJustRatio(12, 7)},
+		"m7": {NewJustRatio(9, 5), NewJustRatio(16, 9), NewJustRatio(7, 4)},
+		"M7": {NewJustRatio(15, 8)},
+		"A7": {NewJustRatio(125, 64)},
+		"d8": {NewJustRatio(48, 25)},
+		"P8": {NewJustRatio(2, 1)},
+	}
+}
+
+// Cents returns the size of the interval in cents in the twelve-tone equal temperament regardless of its direction.
+func (ic *Chromatic) Cents() float64 {
+	if ic == nil {
+		return 0
+	}
+
+	return halftone.EDO12.Cents(int(ic.halfTones))
+}
+
+// JustRatios returns just ratios of the interval: the canonical 5-limit ratio first, and then alternatives if any,
+// e.g. 9/5, 16/9 and 7/4 for minor seventh. Compound intervals get the ratios of the simple ones multiplied by octaves.
+func (ic *Chromatic) JustRatios() ([]JustRatio, error) {
+	simple, err := ic.Simple()
+	if err != nil {
+		return nil, err
+	}
+
+	simple.direction = DirectionAscending
+	ratios, ok := getJustRatios()[simple.Shorthand()]
+	if !ok {
+		return nil, fmt.Errorf("interval '%s': %w", ic.Name(), ErrJustRatioUnknown)
+	}
+
+	octaves := (ic.degrees - simple.degrees) / DegreesInOctave
+	for i, ratio := range ratios {
+		ratios[i] = NewJustRatio(ratio.Numerator<<octaves, ratio.Denominator)
+	}
+
+	return ratios, nil
+}
+
+// JustRatio returns the canonical 5-limit just ratio of the interval, e.g. 5/4 for major third and 45/32 for augmented fourth.
+func (ic *Chromatic) JustRatio() (JustRatio, error) {
+	ratios, err := ic.JustRatios()
+	if err != nil {
+		return JustRatio{}, err
+	}
+
+	return ratios[0], nil
+}
+
+// JustDeviation returns the difference in cents between the equal-tempered interval and its canonical just ratio,
+// e.g. about 13.7 cents for major third, which is wider in the equal temperament.
+func (ic *Chromatic) JustDeviation() (float64, error) {
+	ratio, err := ic.JustRatio()
+	if err != nil {
+		return 0, err
+	}
+
+	return ic.Cents() - ratio.Cents(), nil
+}
+
+// ErrRatioInvalid is returned when the frequency ratio is not positive.
+var ErrRatioInvalid = errors.New("invalid frequency ratio")
+
+// NearestByCents returns the nearest named interval of the twelve-tone equal temperament to the given size in cents,
+// and the deviation in cents: the given size minus the signed size of the interval. Negative sizes give descending intervals.
+// Sizes that are not finite or wider than the widest interval of math.MaxUint8 halftones are rejected.
+func NearestByCents(cents float64) (*Chromatic, float64, error) {
+	if math.IsNaN(cents) || math.IsInf(cents, 0) || math.Round(math.Abs(cents)/halftone.EDO12.StepCents()) > math.MaxUint8 {
+		return nil, 0, fmt.Errorf("get interval nearest to %f cents: %w", cents, ErrIntervalUnknown)
+	}
+
+	halfTones := halftone.EDO12.NearestSteps(math.Abs(cents))
+	deviation := math.Abs(cents) - halftone.EDO12.Cents(halfTones)
+
+	octaves := halftone.HalfTones(0)
+	simpleHalfTones := halftone.HalfTones(halfTones)
+	if simpleHalfTones > HalfTones24 {
+		octaves = (simpleHalfTones - HalfTones12) / halftone.HalfTonesInOctave
+		simpleHalfTones -= octaves * halftone.HalfTonesInOctave
+	}
+
+	ic, err := NewChromatic(simpleHalfTones)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get interval nearest to %f cents: %w", cents, err)
+	}
+
+	ic, err = ic.Compound(uint8(octaves)) //nolint:gosec // amount of octaves is small
+	if err != nil {
+		return nil, 0, fmt.Errorf("get interval nearest to %f cents: %w", cents, err)
+	}
+
+	if cents < 0 {
+		ic.direction = DirectionDescending
+		deviation = -deviation
+	}
+
+	return ic, deviation, nil
+}
+
+// NearestByRatio returns the nearest named interval of the twelve-tone equal temperament to the given frequency ratio,
+// and the deviation of the ratio from the interval in cents, e.g. minor seventh and -31.2 cents for 7/4.
+// Ratios less than 1 give descending intervals.
+func NearestByRatio(ratio float64) (*Chromatic, float64, error) {
+	if ratio <= 0 || math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return nil, 0, fmt.Errorf("ratio %f: %w", ratio, ErrRatioInvalid)
+	}
+
+	return NearestByCents(RatioToCents(ratio))
+}
@@ -0,0 +1,137 @@
+package interval
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+)
+
+func TestJustRatio(t *testing.T) {
+	testCases := []struct {
+		shorthand string
+		ratio     string
+		limit     uint64
+		deviation float64
+	}{
+		{shorthand: "P1", ratio: "1/1", limit: 1, deviation: 0},
+		{shorthand: "m2", ratio: "16/15", limit: 5, deviation: -11.73},
+		{shorthand: "M2", ratio: "9/8", limit: 3, deviation: -3.91},
+		{shorthand: "m3", ratio: "6/5", limit: 5, deviation: -15.64},
+		{shorthand: "M3", ratio: "5/4", limit: 5, deviation: 13.69},
+		{shorthand: "P4", ratio: "4/3", limit: 3, deviation: 1.96},
+		{shorthand: "A4", ratio: "45/32", limit: 5, deviation: 9.78},
+		{shorthand: "d5", ratio: "64/45", limit: 5, deviation: -9.78},
+		{shorthand: "P5", ratio: "3/2", limit: 3, deviation: -1.96},
+		{shorthand: "m6", ratio: "8/5", limit: 5, deviation: -13.69},
+		{shorthand: "M6", ratio: "5/3", limit: 5, deviation: 15.64},
+		{shorthand: "m7", ratio: "9/5", limit: 5, deviation: -17.60},
+		{shorthand: "M7", ratio: "15/8", limit: 5, deviation: 11.73},
+		{shorthand: "P8", ratio: "2/1", limit: 2, deviation: 0},
+		{shorthand: "M9", ratio: "9/4", limit: 3, deviation: -3.91},
+		{shorthand: "M10", ratio: "5/2", limit: 5, deviation: 13.69},
+		{shorthand: "P15", ratio: "4/1", limit: 2, deviation: 0},
+		{shorthand: "-M3", ratio: "5/4", limit: 5, deviation: 13.69},
+	}
+
+	for _, testCase := range testCases {
+		ic := MustParse(testCase.shorthand)
+
+		ratio, err := ic.JustRatio()
+		require.NoError(t, err, testCase.shorthand)
+		assert.Equal(t, testCase.ratio, ratio.String(), testCase.shorthand)
+		assert.Equal(t, testCase.limit, ratio.Limit(), testCase.shorthand)
+
+		deviation, err := ic.JustDeviation()
+		require.NoError(t, err, testCase.shorthand)
+		assert.InDelta(t, testCase.deviation, deviation, 0.01, testCase.shorthand)
+	}
+
+	t.Run("7-limit alternatives", func(t *testing.T) {
+		ratios, err := MustParse("m7").JustRatios()
+		require.NoError(t, err)
+		require.Len(t, ratios, 3)
+		assert.Equal(t, "7/4", ratios[2].String())
+		assert.Equal(t, uint64(7), ratios[2].Limit())
+
+		ratios, err = MustParse("m10").JustRatios()
+		require.NoError(t, err)
+		assert.Equal(t, "12/5", ratios[0].String())
+		assert.Equal(t, "7/3", ratios[1].String())
+	})
+
+	t.Run("unknown ratio", func(t *testing.T) {
+		_, err := MustParse("AA4").JustRatio()
+		require.ErrorIs(t, err, ErrJustRatioUnknown)
+
+		var ic *Chromatic
+		_, err = ic.JustRatio()
+		require.ErrorIs(t, err, ErrIntervalUnknown)
+		assert.Zero(t, ic.Cents())
+	})
+}
+
+func TestChromatic_Cents(t *testing.T) {
+	assert.InDelta(t, 0.0, MustParse("P1").Cents(), 0)
+	assert.InDelta(t, 700.0, MustParse("P5").Cents(), 0)
+	assert.InDelta(t, 1400.0, MustParse("M9").Cents(), 0)
+	assert.InDelta(t, 400.0, MustParse("-M3").Cents(), 0)
+}
+
+func TestNearestByCents(t *testing.T) {
+	testCases := []struct {
+		cents     float64
+		halfTones halftone.HalfTones
+		name      Name
+		direction Direction
+		deviation float64
+	}{
+		{cents: 0, halfTones: 0, name: NamePerfectUnison, deviation: 0},
+		{cents: 386.31, halfTones: 4, name: NameMajorThird, deviation: -13.69},
+		{cents: 968.83, halfTones: 10, name: NameMinorSeventh, deviation: -31.17},
+		{cents: 1200, halfTones: 12, name: NamePerfectOctave, deviation: 0},
+		{cents: 2410, halfTones: 24, name: NamePerfectFifteenth, deviation: 10},
+		{cents: 1800, halfTones: 18, name: NameAugmentedEleventh, deviation: 0},
+		{cents: 3100, halfTones: 31, name: "PerfectNineteenth", deviation: 0},
+		{cents: -498, halfTones: 5, name: NamePerfectFourth, direction: DirectionDescending, deviation: 2},
+	}
+
+	for _, testCase := range testCases {
+		ic, deviation, err := NearestByCents(testCase.cents)
+		require.NoError(t, err, testCase.cents)
+		assert.Equal(t, testCase.halfTones, ic.HalfTones(), testCase.cents)
+		assert.Equal(t, testCase.name, ic.Name(), testCase.cents)
+		assert.Equal(t, testCase.direction, ic.Direction(), testCase.cents)
+		assert.InDelta(t, testCase.deviation, deviation, 0.01, testCase.cents)
+	}
+
+	for _, cents := range []float64{30000, -30000, 25551, math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, _, err := NearestByCents(cents)
+		require.ErrorIs(t, err, ErrIntervalUnknown, cents)
+	}
+
+	ic, _, err := NearestByCents(25549)
+	require.NoError(t, err)
+	assert.Equal(t, halftone.HalfTones(math.MaxUint8), ic.HalfTones())
+}
+
+func TestNearestByRatio(t *testing.T) {
+	ic, deviation, err := NearestByRatio(3.0 / 2)
+	require.NoError(t, err)
+	assert.Equal(t, NamePerfectFifth, ic.Name())
+	assert.InDelta(t, 1.96, deviation, 0.01)
+
+	ic, deviation, err = NearestByRatio(4.0 / 5)
+	require.NoError(t, err)
+	assert.Equal(t, NameMajorThird, ic.Name())
+	assert.Equal(t, DirectionDescending, ic.Direction())
+	assert.InDelta(t, 13.69, deviation, 0.01)
+
+	for _, ratio := range []float64{0, -1} {
+		_, _, err = NearestByRatio(ratio)
+		require.ErrorIs(t, err, ErrRatioInvalid)
+	}
+}
@@ -0,0 +1,230 @@
+package interval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/note"
+)
+
+// getLocalizedQualities returns names of the qualities by locales in the gender of the ordinal names of the intervals.
+func getLocalizedQualities() map[note.Locale]map[Quality]string {
+	return map[note.Locale]map[Quality]string{
+		note.LocaleGerman: {
+			QualityPerfect:          "reine",
+			QualityMajor:            "große",
+			QualityMinor:            "kleine",
+			QualityAugmented:        "übermäßige",
+			QualityDiminished:       "verminderte",
+			QualityDoublyAugmented:  "doppelt übermäßige",
+			QualityDoublyDiminished: "doppelt verminderte",
+		},
+		note.LocaleItalian: {
+			QualityPerfect:          "giusta",
+			QualityMajor:            "maggiore",
+			QualityMinor:            "minore",
+			QualityAugmented:        "aumentata",
+			QualityDiminished:       "diminuita",
+			QualityDoublyAugmented:  "più che aumentata",
+			QualityDoublyDiminished: "più che diminuita",
+		},
+		note.LocaleSpanish: {
+			QualityPerfect:          "justa",
+			QualityMajor:            "mayor",
+			QualityMinor:            "menor",
+			QualityAugmented:        "aumentada",
+			QualityDiminished:       "disminuida",
+			QualityDoublyAugmented:  "doble aumentada",
+			QualityDoublyDiminished: "doble disminuida",
+		},
+		note.LocaleRussian: {
+			QualityPerfect:          "чистая",
+			QualityMajor:            "большая",
+			QualityMinor:            "малая",
+			QualityAugmented:        "увеличенная",
+			QualityDiminished:       "уменьшённая",
+			QualityDoublyAugmented:  "дважды увеличенная",
+			QualityDoublyDiminished: "дважды уменьшённая",
+		},
+	}
+}
+
+// getLocalizedUnisonQualities returns names of the qualities of the unison in the locales where it's masculine unlike the other intervals.
+func getLocalizedUnisonQualities() map[note.Locale]map[Quality]string {
+	return map[note.Locale]map[Quality]string{
+		note.LocaleItalian: {
+			QualityPerfect:         "giusto",
+			QualityAugmented:       "aumentato",
+			QualityDoublyAugmented: "più che aumentato",
+		},
+		note.LocaleSpanish: {
+			QualityPerfect:         "justo",
+			QualityAugmented:       "aumentado",
+			QualityDoublyAugmented: "doble aumentado",
+		},
+	}
+}
+
+// getLocalizedOrdinalNames returns ordinal names of the intervals by locales from the unison up to the double octave.
+func getLocalizedOrdinalNames() map[note.Locale][]string {
+	return map[note.Locale][]string{
+		note.LocaleGerman: {
+			"Prime", "Sekunde", "Terz", "Quarte", "Quinte", "Sexte", "Septime", "Oktave",
+			"None", "Dezime", "Undezime", "Duodezime", "Tredezime", "Quartdezime", "Quintdezime",
+		},
+		note.LocaleItalian: {
+			"unisono", "seconda", "terza", "quarta", "quinta", "sesta", "settima", "ottava",
+			"nona", "decima", "undicesima", "dodicesima", "tredicesima", "quattordicesima", "quindicesima",
+		},
+		note.LocaleSpanish: {
+			"unísono", "segunda", "tercera", "cuarta", "quinta", "sexta", "séptima", "octava",
+			"novena", "décima", "undécima", "duodécima", "decimotercera", "decimocuarta", "decimoquinta",
+		},
+		note.LocaleRussian: {
+			"прима", "секунда", "терция", "кварта", "квинта", "секста", "септима", "октава",
+			"нона", "децима", "ундецима", "дуодецима", "терцдецима", "квартдецима", "квинтдецима",
+		},
+	}
+}
+
+// getLocalizedSpecialNames returns translations of the interval names which are not made of quality and number.
+func getLocalizedSpecialNames() map[note.Locale]map[Name]string {
+	return map[note.Locale]map[Name]string{
+		note.LocaleGerman:  {NameTritone: "Tritonus", NameOctaveWithTritone: "Oktave mit Tritonus"},
+		note.LocaleItalian: {NameTritone: "tritono", NameOctaveWithTritone: "ottava con tritono"},
+		note.LocaleSpanish: {NameTritone: "tritono", NameOctaveWithTritone: "octava con tritono"},
+		note.LocaleRussian: {NameTritone: "тритон", NameOctaveWithTritone: "октава с тритоном"},
+	}
+}
+
+// getQualitiesByPrefixLength returns the qualities with the longer names first, so "DoublyAugmented" is found before "Augmented".
+func getQualitiesByPrefixLength() []Quality {
+	return []Quality{
+		QualityDoublyAugmented, QualityDoublyDiminished,
+		QualityAugmented, QualityDiminished, QualityPerfect, QualityMajor, QualityMinor,
+	}
+}
+
+// isQualityOfDegree checks if the quality is possible for the interval with the given amount of degrees:
+// perfect intervals are unisons, fourths, fifths and their compounds, major and minor ones are the others, and the unison can't be diminished.
+func isQualityOfDegree(quality Quality, degrees degree.Number) bool {
+	switch quality {
+	case QualityPerfect:
+		return isPerfectDegree(degrees)
+	case QualityMajor, QualityMinor:
+		return !isPerfectDegree(degrees)
+	case QualityDiminished, QualityDoublyDiminished:
+		return degrees != 0
+	}
+
+	return true
+}
+
+// qualityAndDegrees returns the quality and the amount of degrees of the interval by its name made of them, e.g. "MinorThird".
+func (n Name) qualityAndDegrees() (Quality, degree.Number, bool) {
+	for _, quality := range getQualitiesByPrefixLength() {
+		ordinal, ok := strings.CutPrefix(string(n), string(quality))
+		if !ok {
+			continue
+		}
+
+		for degrees, ordinalName := range getOrdinalNames() {
+			if Name(ordinal) == ordinalName && isQualityOfDegree(quality, degree.Number(degrees)) { //nolint:gosec // ordinal names are few
+				return quality, degree.Number(degrees), true //nolint:gosec // ordinal names are few
+			}
+		}
+
+		return "", 0, false
+	}
+
+	return "", 0, false
+}
+
+// formatLocalized returns the name of the interval with the quality and the amount of degrees in the locale.
+func formatLocalized(quality Quality, degrees degree.Number, locale note.Locale) (string, bool) {
+	ordinalNames := getLocalizedOrdinalNames()[locale]
+	if int(degrees) >= len(ordinalNames) {
+		return "", false
+	}
+
+	qualityName, ok := getLocalizedUnisonQualities()[locale][quality]
+	if !ok || degrees != 0 {
+		qualityName, ok = getLocalizedQualities()[locale][quality]
+	}
+	if !ok {
+		return "", false
+	}
+
+	// Romance languages put the quality after the number
+	switch locale {
+	case note.LocaleItalian, note.LocaleSpanish:
+		return ordinalNames[degrees] + " " + qualityName, true
+	default:
+		return qualityName + " " + ordinalNames[degrees], true
+	}
+}
+
+// Format returns the interval name in the locale, e.g. "große Terz" for MajorThird in German, "quinta giusta" for PerfectFifth in Italian
+// and "уменьшённая септима" for DiminishedSeventh in Russian. Intervals wider than the double octave are named as in English.
+func (n Name) Format(locale note.Locale) (string, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	if localized, ok := getLocalizedSpecialNames()[locale][n]; ok {
+		return localized, nil
+	}
+
+	if quality, degrees, ok := n.qualityAndDegrees(); ok {
+		if localized, ok := formatLocalized(quality, degrees, locale); ok {
+			return localized, nil
+		}
+	}
+
+	return string(n), nil
+}
+
+// normalizeName makes the name comparable with other spellings of it: lowercase without spaces, hyphens and underscores, with "ё" written as "е".
+func normalizeName(s string) string {
+	s = strings.ToLower(s)
+
+	return strings.NewReplacer(" ", "", "-", "", "_", "", "ё", "е").Replace(s)
+}
+
+// ParseName returns the interval name by its name in the locale or by the English name itself,
+// e.g. NameMajorThird for "große Terz" in German and NamePerfectFifth for "чистая квинта" in Russian.
+// Parsing ignores case, spaces and hyphens.
+func ParseName(s string, locale note.Locale) (Name, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	normalized := normalizeName(s)
+	for _, intervalName := range []Name{NameTritone, NameOctaveWithTritone} {
+		localized, ok := getLocalizedSpecialNames()[locale][intervalName]
+		if normalizeName(string(intervalName)) == normalized || (ok && normalizeName(localized) == normalized) {
+			return intervalName, nil
+		}
+	}
+
+	for _, quality := range getQualitiesByPrefixLength() {
+		for degrees, ordinalName := range getOrdinalNames() {
+			if !isQualityOfDegree(quality, degree.Number(degrees)) { //nolint:gosec // ordinal names are few
+				continue
+			}
+
+			intervalName := Name(quality) + ordinalName
+			if normalizeName(string(intervalName)) == normalized {
+				return intervalName, nil
+			}
+
+			localized, ok := formatLocalized(quality, degree.Number(degrees), locale) //nolint:gosec // ordinal names are few
+			if ok && normalizeName(localized) == normalized {
+				return intervalName, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("parse interval name '%s' in locale '%s': %w", s, locale, ErrIntervalUnknown)
+}
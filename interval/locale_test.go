@@ -0,0 +1,98 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+)
+
+func TestName_Format(t *testing.T) {
+	testCases := []struct {
+		name     Name
+		locale   note.Locale
+		expected string
+	}{
+		{name: NameMajorThird, locale: note.LocaleEnglish, expected: "MajorThird"},
+		{name: NameMajorThird, locale: note.LocaleGerman, expected: "große Terz"},
+		{name: NamePerfectUnison, locale: note.LocaleGerman, expected: "reine Prime"},
+		{name: NameAugmentedFourth, locale: note.LocaleGerman, expected: "übermäßige Quarte"},
+		{name: NameTritone, locale: note.LocaleGerman, expected: "Tritonus"},
+		{name: NameMinorNinth, locale: note.LocaleGerman, expected: "kleine None"},
+		{name: NamePerfectFifth, locale: note.LocaleItalian, expected: "quinta giusta"},
+		{name: NamePerfectUnison, locale: note.LocaleItalian, expected: "unisono giusto"},
+		{name: NameAugmentedUnison, locale: note.LocaleItalian, expected: "unisono aumentato"},
+		{name: NameMinorSeventh, locale: note.LocaleItalian, expected: "settima minore"},
+		{name: NameDiminishedFifth, locale: note.LocaleSpanish, expected: "quinta disminuida"},
+		{name: NameDiminishedSeventh, locale: note.LocaleRussian, expected: "уменьшённая септима"},
+		{name: NameMinorSecond, locale: note.LocaleRussian, expected: "малая секунда"},
+		{name: NamePerfectFifteenth, locale: note.LocaleRussian, expected: "чистая квинтдецима"},
+		{name: NameAugmentedEleventh, locale: note.LocaleRussian, expected: "увеличенная ундецима"},
+		{name: NameOctaveWithTritone, locale: note.LocaleRussian, expected: "октава с тритоном"},
+		{name: "DoublyAugmentedSixth", locale: note.LocaleRussian, expected: "дважды увеличенная секста"},
+		{name: "PerfectNineteenth", locale: note.LocaleRussian, expected: "PerfectNineteenth"},
+		{name: "PerfectThird", locale: note.LocaleRussian, expected: "PerfectThird"},
+	}
+
+	for _, testCase := range testCases {
+		formatted, err := testCase.name.Format(testCase.locale)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, formatted, "name: %s, locale: %s", testCase.name, testCase.locale)
+	}
+
+	_, err := NameMajorThird.Format("fr")
+	require.ErrorIs(t, err, note.ErrLocaleUnknown)
+}
+
+func TestParseName(t *testing.T) {
+	testCases := []struct {
+		s        string
+		locale   note.Locale
+		expected Name
+	}{
+		{s: "MajorThird", locale: note.LocaleEnglish, expected: NameMajorThird},
+		{s: "major third", locale: note.LocaleRussian, expected: NameMajorThird},
+		{s: "Tritone", locale: note.LocaleEnglish, expected: NameTritone},
+		{s: "Große Terz", locale: note.LocaleGerman, expected: NameMajorThird},
+		{s: "doppelt verminderte Septime", locale: note.LocaleGerman, expected: "DoublyDiminishedSeventh"},
+		{s: "quinta giusta", locale: note.LocaleItalian, expected: NamePerfectFifth},
+		{s: "Unisono giusto", locale: note.LocaleItalian, expected: NamePerfectUnison},
+		{s: "tritono", locale: note.LocaleItalian, expected: NameTritone},
+		{s: "tercera menor", locale: note.LocaleSpanish, expected: NameMinorThird},
+		{s: "чистая квинта", locale: note.LocaleRussian, expected: NamePerfectFifth},
+		{s: "Уменьшенная септима", locale: note.LocaleRussian, expected: NameDiminishedSeventh},
+		{s: "увеличенная кварта", locale: note.LocaleRussian, expected: NameAugmentedFourth},
+		{s: "большая нона", locale: note.LocaleRussian, expected: NameMajorNinth},
+	}
+
+	for _, testCase := range testCases {
+		parsed, err := ParseName(testCase.s, testCase.locale)
+		require.NoError(t, err, testCase.s)
+		assert.Equal(t, testCase.expected, parsed, "s: %s, locale: %s", testCase.s, testCase.locale)
+	}
+
+	for _, s := range []string{"чистая терция", "große Terz", "DiminishedUnison", "Unknown"} {
+		_, err := ParseName(s, note.LocaleRussian)
+		require.ErrorIs(t, err, ErrIntervalUnknown, s)
+	}
+
+	_, err := ParseName("MajorThird", "fr")
+	require.ErrorIs(t, err, note.ErrLocaleUnknown)
+
+	t.Run("ParseName: formatted names are parsed back", func(t *testing.T) {
+		for _, locale := range []note.Locale{note.LocaleEnglish, note.LocaleGerman, note.LocaleItalian, note.LocaleSpanish, note.LocaleRussian} {
+			for halfTones := HalfTones0; halfTones <= HalfTones24; halfTones++ {
+				ic, err := NewChromatic(halfTones)
+				require.NoError(t, err)
+
+				formatted, err := ic.Name().Format(locale)
+				require.NoError(t, err)
+				parsed, err := ParseName(formatted, locale)
+				require.NoError(t, err, formatted)
+				assert.Equal(t, ic.Name(), parsed, formatted)
+			}
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-muse/muse/degree"
+)
+
+const (
+	// descendingPrefix marks descending intervals in shorthand notation, e.g. "-P4".
+	descendingPrefix = "-"
+	// augmentedPrefix is the chord-symbol sign of augmented interval, e.g. "+5".
+	augmentedPrefix = "+"
+	sharpSign       = "#"
+	flatSign        = "b"
+)
+
+// qualityOffset returns the difference in halftones between the interval with the given quality prefix
+// and the major or perfect interval with the same amount of degrees.
+// The prefix is either the quality (P, M, m, A, d, AA, dd, +) or the chord-symbol alterations (#, b, bb etc.).
+func qualityOffset(prefix string, degrees degree.Number) (int, error) {
+	isPerfect := isPerfectDegree(degrees)
+	shortNames := getQualityShortNames()
+
+	switch Name(prefix) {
+	case shortNames[QualityPerfect]:
+		if isPerfect {
+			return 0, nil
+		}
+	case shortNames[QualityMajor]:
+		if !isPerfect {
+			return 0, nil
+		}
+	case shortNames[QualityMinor]:
+		if !isPerfect {
+			return -1, nil
+		}
+	case shortNames[QualityAugmented], augmentedPrefix:
+		return 1, nil
+	case shortNames[QualityDoublyAugmented]:
+		return 2, nil //nolint:mnd
+	case shortNames[QualityDiminished]:
+		if isPerfect {
+			return -1, nil
+		}
+
+		return -2, nil //nolint:mnd
+	case shortNames[QualityDoublyDiminished]:
+		if isPerfect {
+			return -2, nil //nolint:mnd
+		}
+
+		return -3, nil //nolint:mnd
+	default:
+		// Chord-symbol alterations of major and perfect intervals
+		sharps, flats := strings.Count(prefix, sharpSign), strings.Count(prefix, flatSign)
+		if sharps+flats == len(prefix) && (sharps == 0 || flats == 0) {
+			return sharps - flats, nil
+		}
+	}
+
+	return 0, fmt.Errorf("quality '%s' with %d degrees: %w", prefix, degrees, ErrIntervalQualityUnknown)
+}
+
+// Parse creates interval from the shorthand notation: quality and number of the interval.
+// Qualities are P (perfect), M (major), m (minor), A or + (augmented), d (diminished), AA and dd (doubly augmented and diminished),
+// e.g. "m3", "M7", "P5", "A4", "d5", "+5", "dd7".
+// Chord-symbol notation with alterations of major and perfect intervals is also supported, e.g. "b9", "#11", "bb7", and just "13" for major thirteenth.
+// Leading minus makes the interval descending, e.g. "-P4".
+func Parse(s string) (*Chromatic, error) {
+	text := strings.TrimSpace(s)
+	isDescending := strings.HasPrefix(text, descendingPrefix)
+	text = strings.TrimPrefix(text, descendingPrefix)
+
+	numberIndex := strings.IndexFunc(text, unicode.IsDigit)
+	if numberIndex < 0 {
+		return nil, fmt.Errorf("parse interval '%s': no number: %w", s, ErrIntervalUnknown)
+	}
+
+	number, err := strconv.Atoi(text[numberIndex:])
+	if err != nil || number < 1 || number-1 > math.MaxUint8 {
+		return nil, fmt.Errorf("parse interval '%s': invalid number: %w", s, ErrIntervalUnknown)
+	}
+	degrees := number - 1
+
+	offset, err := qualityOffset(text[:numberIndex], degree.Number(degrees))
+	if err != nil {
+		return nil, fmt.Errorf("parse interval '%s': %w: %w", s, ErrIntervalUnknown, err)
+	}
+
+	halfTones := referenceHalfTones(degree.Number(degrees)) + offset
+	if halfTones < 0 {
+		return nil, fmt.Errorf("parse interval '%s': %w: %w", s, ErrIntervalUnknown, ErrIntervalQualityUnknown)
+	}
+
+	if halfTones > math.MaxUint8 {
+		return nil, fmt.Errorf("parse interval '%s': too wide: %w", s, ErrIntervalUnknown)
+	}
+
+	if isDescending {
+		halfTones, degrees = -halfTones, -degrees
+	}
+
+	ic, err := newIntervalBySignedSpelling(halfTones, degrees)
+	if err != nil {
+		return nil, fmt.Errorf("parse interval '%s': %w: %w", s, ErrIntervalUnknown, err)
+	}
+
+	return ic, nil
+}
+
+// MustParse creates interval from the shorthand notation as Parse does, if you are confident in the correctness of the notation.
+func MustParse(s string) *Chromatic {
+	ic, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return ic
+}
+
+// Shorthand returns the interval in the shorthand notation with quality and number, e.g. "m3", "P5", "A4", "dd7",
+// and "-P4" for descending interval. The result can be parsed back by Parse.
+// It returns empty name if the quality of the interval is unknown.
+func (ic *Chromatic) Shorthand() Name {
+	if ic == nil {
+		return ""
+	}
+
+	quality, err := qualityByHalfTonesAndDegrees(int(ic.halfTones), ic.degrees)
+	if err != nil {
+		return ""
+	}
+
+	return ic.directionPrefix() + getQualityShortNames()[quality] + Name(strconv.Itoa(int(ic.degrees)+1))
+}
+
+// AlterationShorthand returns the interval in the chord-symbol notation with alterations of major and perfect intervals,
+// e.g. "b3", "5", "#11", "bb7", and "-b3" for descending interval. The result can be parsed back by Parse.
+func (ic *Chromatic) AlterationShorthand() Name {
+	if ic == nil {
+		return ""
+	}
+
+	offset := int(ic.halfTones) - referenceHalfTones(ic.degrees)
+	alterations := strings.Repeat(sharpSign, max(offset, 0)) + strings.Repeat(flatSign, max(-offset, 0))
+
+	return ic.directionPrefix() + Name(alterations) + Name(strconv.Itoa(int(ic.degrees)+1))
+}
+
+// directionPrefix returns the prefix of the interval in shorthand notation according to its direction.
+func (ic *Chromatic) directionPrefix() Name {
+	if ic.direction == DirectionDescending {
+		return descendingPrefix
+	}
+
+	return ""
+}
@@ -0,0 +1,118 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		shorthand string
+		name      Name
+		halfTones halftone.HalfTones
+		degrees   degree.Number
+		direction Direction
+	}{
+		{shorthand: "m3", name: NameMinorThird, halfTones: 3, degrees: 2},
+		{shorthand: "M7", name: NameMajorSeventh, halfTones: 11, degrees: 6},
+		{shorthand: "P5", name: NamePerfectFifth, halfTones: 7, degrees: 4},
+		{shorthand: "A4", name: NameAugmentedFourth, halfTones: 6, degrees: 3},
+		{shorthand: "d5", name: NameDiminishedFifth, halfTones: 6, degrees: 4},
+		{shorthand: "+5", name: NameAugmentedFifth, halfTones: 8, degrees: 4},
+		{shorthand: "b9", name: NameMinorNinth, halfTones: 13, degrees: 8},
+		{shorthand: "#11", name: "AugmentedEleventh", halfTones: 18, degrees: 10},
+		{shorthand: "13", name: NameMajorThirteenth, halfTones: 21, degrees: 12},
+		{shorthand: "11", name: NamePerfectEleventh, halfTones: 17, degrees: 10},
+		{shorthand: "dd7", name: "DoublyDiminishedSeventh", halfTones: 8, degrees: 6},
+		{shorthand: "bb7", name: NameDiminishedSeventh, halfTones: 9, degrees: 6},
+		{shorthand: "b5", name: NameDiminishedFifth, halfTones: 6, degrees: 4},
+		{shorthand: "AA1", name: "DoublyAugmentedUnison", halfTones: 2, degrees: 0},
+		{shorthand: "P8", name: NamePerfectOctave, halfTones: 12, degrees: 7},
+		{shorthand: "P1", name: NamePerfectUnison, halfTones: 0, degrees: 0},
+		{shorthand: "M16", name: "MajorSixteenth", halfTones: 26, degrees: 15},
+		{shorthand: "-P4", name: NamePerfectFourth, halfTones: 5, degrees: 3, direction: DirectionDescending},
+		{shorthand: " -b3 ", name: NameMinorThird, halfTones: 3, degrees: 2, direction: DirectionDescending},
+	}
+
+	for _, testCase := range testCases {
+		ic, err := Parse(testCase.shorthand)
+		require.NoError(t, err, testCase.shorthand)
+		assert.Equal(t, testCase.name, ic.Name(), testCase.shorthand)
+		assert.Equal(t, testCase.halfTones, ic.HalfTones(), testCase.shorthand)
+		assert.Equal(t, testCase.degrees, ic.Degrees(), testCase.shorthand)
+		assert.Equal(t, testCase.direction, ic.Direction(), testCase.shorthand)
+	}
+
+	for _, shorthand := range []string{"", "M", "P3", "M5", "m4", "x3", "b#5", "0", "P0", "d1", "-", "3b", "ddd5", "300", "P260", "M150", "257"} {
+		_, err := Parse(shorthand)
+		require.ErrorIs(t, err, ErrIntervalUnknown, shorthand)
+	}
+
+	assert.Panics(t, func() { MustParse("P3") })
+	assert.Equal(t, MinorThird(), MustParse("m3"))
+}
+
+func TestChromatic_Shorthand(t *testing.T) {
+	testCases := []struct {
+		interval            *Chromatic
+		shorthand           Name
+		alterationShorthand Name
+	}{
+		{interval: MinorThird(), shorthand: "m3", alterationShorthand: "b3"},
+		{interval: PerfectFifth(), shorthand: "P5", alterationShorthand: "5"},
+		{interval: Tritone(), shorthand: "A4", alterationShorthand: "#4"},
+		{interval: DiminishedSeventh(), shorthand: "d7", alterationShorthand: "bb7"},
+		{interval: MajorThirteenth(), shorthand: "M13", alterationShorthand: "13"},
+		{interval: MustParse("#11"), shorthand: "A11", alterationShorthand: "#11"},
+		{interval: MustParse("-P4"), shorthand: "-P4", alterationShorthand: "-4"},
+		{interval: MustParse("dd5"), shorthand: "dd5", alterationShorthand: "bb5"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.shorthand, testCase.interval.Shorthand())
+		assert.Equal(t, testCase.alterationShorthand, testCase.interval.AlterationShorthand())
+
+		for _, shorthand := range []Name{testCase.shorthand, testCase.alterationShorthand} {
+			parsed, err := Parse(string(shorthand))
+			require.NoError(t, err, shorthand)
+			assert.Equal(t, testCase.interval.HalfTones(), parsed.HalfTones(), shorthand)
+			assert.Equal(t, testCase.interval.Degrees(), parsed.Degrees(), shorthand)
+			assert.Equal(t, testCase.interval.Direction(), parsed.Direction(), shorthand)
+		}
+	}
+
+	assert.Empty(t, (*Chromatic)(nil).Shorthand())
+	assert.Empty(t, (*Chromatic)(nil).AlterationShorthand())
+}
+
+func TestNewIntervalByName(t *testing.T) {
+	for _, ic := range []*Chromatic{
+		PerfectUnison(), MinorSecond(), MajorSecond(), MinorThird(), MajorThird(), PerfectFourth(), Tritone(), PerfectFifth(),
+		MinorSixth(), MajorSixth(), MinorSeventh(), MajorSeventh(), PerfectOctave(),
+		MinorNinth(), MajorNinth(), MinorTenth(), MajorTenth(), PerfectEleventh(), OctaveWithTritone(), PerfectTwelfth(),
+		MinorThirteenth(), MajorThirteenth(), MinorFourteenth(), MajorFourteenth(), PerfectFifteenth(),
+		DiminishedSecond(), AugmentedUnison(), DiminishedThird(), AugmentedSecond(), DiminishedFourth(), AugmentedThird(),
+		DiminishedFifth(), AugmentedFourth(), DiminishedSixth(), AugmentedFifth(), DiminishedSeventh(), AugmentedSixth(),
+		DiminishedOctave(), AugmentedSeventh(),
+	} {
+		byName, err := NewIntervalByName(ic.Name())
+		require.NoError(t, err)
+		assert.Equal(t, ic, byName)
+
+		byShortName, err := NewIntervalByName(ic.ShortName())
+		require.NoError(t, err)
+		assert.Equal(t, ic, byShortName)
+	}
+
+	ic, err := NewIntervalByName("#11")
+	require.NoError(t, err)
+	assert.Equal(t, Name("AugmentedEleventh"), ic.Name())
+
+	_, err = NewIntervalByName("Unknown")
+	require.ErrorIs(t, err, ErrIntervalUnknown)
+}
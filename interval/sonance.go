@@ -1,5 +1,7 @@
 package interval
 
+import "github.com/go-muse/muse/halftone"
+
 // Hindemith Grading of Sonances
 // The most consonant intervals have more "weight"
 
@@ -21,4 +23,57 @@ const (
 	SonancePerfectUnison
 )
 
-// TODO: выходящие за пределы октавы интервалы
+// Intervals wider than an octave have the sonance of the simple intervals they are reduced to,
+// e.g. minor ninth is graded as minor second and perfect fifteenth as perfect octave.
+
+// getSonancesByHalfTones returns sonances of the simple intervals by amount of halftones.
+func getSonancesByHalfTones() []Sonance {
+	return []Sonance{
+		SonancePerfectUnison,
+		SonanceMinorSecond,
+		SonanceMajorSecond,
+		SonanceMinorThird,
+		SonanceMajorThird,
+		SonancePerfectFourth,
+		SonanceTritone,
+		SonancePerfectFifth,
+		SonanceMinorSixth,
+		SonanceMajorSixth,
+		SonanceMinorSeventh,
+		SonanceMajorSeventh,
+		SonancePerfectOctave,
+	}
+}
+
+// SonanceByHalfTones returns the sonance of the interval of any size by amount of halftones in it.
+func SonanceByHalfTones(halfTones halftone.HalfTones) Sonance {
+	simple := halfTones % halftone.HalfTonesInOctave
+	if simple == 0 && halfTones > 0 {
+		return SonancePerfectOctave
+	}
+
+	return getSonancesByHalfTones()[simple]
+}
+
+// Dissonance returns the sonance as a number from 0 for the perfect unison to 1 for the minor second.
+// Unknown sonance is considered as the most dissonant.
+func (s Sonance) Dissonance() float64 {
+	if s < SonanceMinorSecond || s > SonancePerfectUnison {
+		return 1
+	}
+
+	return float64(SonancePerfectUnison-s) / float64(SonancePerfectUnison-SonanceMinorSecond)
+}
+
+// SonancesWithDissonanceAbove returns the sonances which dissonance is greater than the threshold.
+// The result can be used to filter out dissonant intervals with AddFilterBySonance.
+func SonancesWithDissonanceAbove(threshold float64) []Sonance {
+	sonances := make([]Sonance, 0, SonancePerfectUnison)
+	for s := SonanceMinorSecond; s <= SonancePerfectUnison; s++ {
+		if s.Dissonance() > threshold {
+			sonances = append(sonances, s)
+		}
+	}
+
+	return sonances
+}
@@ -0,0 +1,93 @@
+package interval
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/note"
+)
+
+// TransposeNote returns a copy of the note moved by the interval in the given direction.
+// The spelling is kept: C transposed up by augmented second is D#, and by minor third is Eb.
+// Descending interval transposed in ascending direction moves the note down and vice versa.
+func TransposeNote(n *note.Note, ic *Chromatic, direction Direction) (*note.Note, error) {
+	if n == nil {
+		return nil, ErrNoteEmpty
+	}
+
+	if ic == nil {
+		return nil, ErrIntervalUnknown
+	}
+
+	halfTones, degrees := ic.signed()
+	if direction == DirectionDescending {
+		halfTones, degrees = -halfTones, -degrees
+	}
+
+	transposed, err := n.TransposeBySpelling(halfTones, degrees)
+	if err != nil {
+		return nil, fmt.Errorf("transpose note '%s' by interval '%s' %s: %w", n.Name(), ic.Name(), direction, err)
+	}
+
+	return transposed, nil
+}
+
+// TransposeNotes returns copies of the notes moved by the interval in the given direction.
+func TransposeNotes(notes note.Notes, ic *Chromatic, direction Direction) (note.Notes, error) {
+	transposed := make(note.Notes, 0, len(notes))
+	for _, n := range notes {
+		transposedNote, err := TransposeNote(n, ic, direction)
+		if err != nil {
+			return nil, err
+		}
+
+		transposed = append(transposed, transposedNote)
+	}
+
+	return transposed, nil
+}
+
+// ToKey returns the interval to transpose music from one key to another by their tonics.
+// The shortest way is chosen, so the interval goes up by no more than a tritone, otherwise it goes down.
+// Octaves of the tonics are ignored.
+func ToKey(fromTonic, toTonic *note.Note) (*Chromatic, error) {
+	if fromTonic == nil || toTonic == nil {
+		return nil, ErrNoteEmpty
+	}
+
+	ic, err := Between(fromTonic.Copy().SetOctave(nil), toTonic.Copy().SetOctave(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if ic.HalfTones() > HalfTones6 {
+		inverted, err := ic.Invert()
+		if err != nil {
+			return nil, err
+		}
+		inverted.direction = DirectionDescending
+
+		return inverted, nil
+	}
+
+	return ic, nil
+}
+
+// TransposeNotesToKey returns copies of the notes moved from the key with one tonic to the key with another one.
+// The notes are moved the shortest way and their spelling is simplified, so there are no double sharps or double flats, E#, B#, Fb and Cb.
+func TransposeNotesToKey(notes note.Notes, fromTonic, toTonic *note.Note) (note.Notes, error) {
+	ic, err := ToKey(fromTonic, toTonic)
+	if err != nil {
+		return nil, fmt.Errorf("get interval from key '%s' to key '%s': %w", fromTonic.Name(), toTonic.Name(), err)
+	}
+
+	transposed, err := TransposeNotes(notes, ic, DirectionAscending)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, n := range transposed {
+		transposed[i] = n.Simplify()
+	}
+
+	return transposed, nil
+}
@@ -0,0 +1,106 @@
+package interval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestTransposeNote(t *testing.T) {
+	testCases := []struct {
+		note      *note.Note
+		interval  *Chromatic
+		direction Direction
+		want      *note.Note
+	}{
+		{note: note.MustNewNote(note.C), interval: MinorThird(), want: note.MustNewNote(note.EFLAT)},
+		{note: note.MustNewNote(note.C), interval: AugmentedSecond(), want: note.MustNewNote(note.DSHARP)},
+		{note: note.MustNewNote(note.C), interval: MinorThird(), direction: DirectionDescending, want: note.MustNewNote(note.A)},
+		{note: note.MustNewNote(note.FSHARP), interval: AugmentedFourth(), want: note.MustNewNote(note.BSHARP)},
+		{
+			note:     note.MustNewNoteWithOctave(note.G, octave.Number4),
+			interval: MajorNinth(), want: note.MustNewNoteWithOctave(note.A, octave.Number5),
+		},
+		{
+			note:     note.MustNewNoteWithOctave(note.D, octave.Number4),
+			interval: PerfectFifth(), direction: DirectionDescending, want: note.MustNewNoteWithOctave(note.G, octave.Number3),
+		},
+	}
+
+	for _, testCase := range testCases {
+		transposed, err := TransposeNote(testCase.note, testCase.interval, testCase.direction)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, transposed)
+	}
+
+	descendingThird, err := PerfectUnison().Sub(MajorThird())
+	require.NoError(t, err)
+	transposed, err := TransposeNote(note.MustNewNote(note.E), descendingThird, DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, note.C, transposed.Name())
+	transposed, err = TransposeNote(note.MustNewNote(note.E), descendingThird, DirectionDescending)
+	require.NoError(t, err)
+	assert.Equal(t, note.GSHARP, transposed.Name())
+
+	_, err = TransposeNote(nil, MajorThird(), DirectionAscending)
+	require.ErrorIs(t, err, ErrNoteEmpty)
+
+	_, err = TransposeNote(note.MustNewNote(note.C), nil, DirectionAscending)
+	require.ErrorIs(t, err, ErrIntervalUnknown)
+
+	_, err = TransposeNote(note.MustNewNote(note.GSHARP2), AugmentedUnison(), DirectionAscending)
+	require.ErrorIs(t, err, note.ErrTranspositionOutOfRange)
+}
+
+func TestTransposeNotes(t *testing.T) {
+	notes := note.MustNewNotesFromNoteNames(note.C, note.E, note.G)
+	transposed, err := TransposeNotes(notes, MinorSecond(), DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.DFLAT, note.F, note.AFLAT), transposed)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.C, note.E, note.G), notes)
+
+	transposed, err = TransposeNotes(notes, AugmentedUnison(), DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.CSHARP, note.ESHARP, note.GSHARP), transposed)
+}
+
+func TestToKey(t *testing.T) {
+	testCases := []struct {
+		from, to  note.Name
+		want      Name
+		direction Direction
+	}{
+		{from: note.C, to: note.D, want: NameMajorSecond},
+		{from: note.C, to: note.A, want: NameMinorThird, direction: DirectionDescending},
+		{from: note.C, to: note.FSHARP, want: NameAugmentedFourth},
+		{from: note.C, to: note.GFLAT, want: NameDiminishedFifth},
+		{from: note.E, to: note.C, want: NameMajorThird, direction: DirectionDescending},
+		{from: note.G, to: note.G, want: NamePerfectUnison},
+	}
+
+	for _, testCase := range testCases {
+		ic, err := ToKey(note.MustNewNote(testCase.from), note.MustNewNote(testCase.to))
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, ic.Name(), "%s - %s", testCase.from, testCase.to)
+		assert.Equal(t, testCase.direction, ic.Direction(), "%s - %s", testCase.from, testCase.to)
+	}
+}
+
+func TestTransposeNotesToKey(t *testing.T) {
+	notes := note.MustNewNotesFromNoteNames(note.C, note.E, note.G, note.B)
+	transposed, err := TransposeNotesToKey(notes, note.MustNewNote(note.C), note.MustNewNote(note.GSHARP))
+	require.NoError(t, err)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.GSHARP, note.C, note.DSHARP, note.G), transposed)
+
+	notes = note.MustNewNotesFromNoteNames(note.A, note.CSHARP, note.E)
+	transposed, err = TransposeNotesToKey(notes, note.MustNewNote(note.A), note.MustNewNote(note.FSHARP))
+	require.NoError(t, err)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.FSHARP, note.ASHARP, note.CSHARP), transposed)
+
+	_, err = TransposeNotesToKey(notes, nil, note.MustNewNote(note.C))
+	require.ErrorIs(t, err, ErrNoteEmpty)
+}
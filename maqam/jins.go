@@ -0,0 +1,195 @@
+package maqam
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+)
+
+// JinsName is a name of a jins (plural ajnas).
+type JinsName string
+
+const (
+	// Arabic ajnas in quarter tones (24-EDO).
+
+	JinsNameRast     = JinsName("Rast")
+	JinsNameBayati   = JinsName("Bayati")
+	JinsNameHijaz    = JinsName("Hijaz")
+	JinsNameSaba     = JinsName("Saba")
+	JinsNameSikah    = JinsName("Sikah")
+	JinsNameNahawand = JinsName("Nahawand")
+	JinsNameAjam     = JinsName("Ajam")
+	JinsNameKurd     = JinsName("Kurd")
+	JinsNameNikriz   = JinsName("Nikriz")
+
+	// Turkish çeşniler in Holdrian commas (53-EDO).
+
+	JinsNameCesniRast    = JinsName("CesniRast")
+	JinsNameCesniUssak   = JinsName("CesniUssak")
+	JinsNameCesniHicaz   = JinsName("CesniHicaz")
+	JinsNameCesniBuselik = JinsName("CesniBuselik")
+	JinsNameCesniKurdi   = JinsName("CesniKurdi")
+)
+
+// Jins is a small group of notes (usually a trichord, tetrachord or pentachord) that is a building block of maqamat.
+// Its template contains steps of the division of the octave between the notes of the jins.
+type Jins struct {
+	name     JinsName
+	edo      halftone.EDO
+	template mode.Template
+}
+
+// NewJins creates a jins from the steps of the given division of the octave.
+func NewJins(name JinsName, edo halftone.EDO, steps ...halftone.HalfTones) *Jins {
+	return &Jins{name: name, edo: edo, template: mode.Template(halftone.NewTemplate(steps...))}
+}
+
+// Name returns the name of the jins.
+func (j *Jins) Name() JinsName {
+	if j == nil {
+		return ""
+	}
+
+	return j.name
+}
+
+// EDO returns the division of the octave the steps of the jins are counted in.
+func (j *Jins) EDO() halftone.EDO {
+	if j == nil {
+		return 0
+	}
+
+	return j.edo
+}
+
+// Template returns a copy of the steps between the notes of the jins.
+func (j *Jins) Template() mode.Template {
+	if j == nil {
+		return nil
+	}
+
+	return mode.Template(halftone.NewTemplate(j.template...))
+}
+
+// Length returns amount of notes in the jins.
+func (j *Jins) Length() int {
+	if j == nil {
+		return 0
+	}
+
+	return len(j.template) + 1
+}
+
+// Span returns the distance between the first and the last notes of the jins in steps.
+func (j *Jins) Span() halftone.HalfTones {
+	if j == nil {
+		return 0
+	}
+
+	return j.template.GetHalftonesByDegreeNum(j.template.Length())
+}
+
+// positions returns distances of the notes of the jins from its first note in steps.
+func (j *Jins) positions() []int {
+	positions := make([]int, 0, j.Length())
+	position := 0
+	positions = append(positions, position)
+	for _, steps := range j.template {
+		position += int(steps)
+		positions = append(positions, position)
+	}
+
+	return positions
+}
+
+// Arabic ajnas
+
+func JinsRast() *Jins {
+	return NewJins(JinsNameRast, halftone.EDO24, 4, 3, 3)
+}
+
+func JinsBayati() *Jins {
+	return NewJins(JinsNameBayati, halftone.EDO24, 3, 3, 4)
+}
+
+func JinsHijaz() *Jins {
+	return NewJins(JinsNameHijaz, halftone.EDO24, 2, 6, 2)
+}
+
+func JinsSaba() *Jins {
+	return NewJins(JinsNameSaba, halftone.EDO24, 3, 3, 2)
+}
+
+func JinsSikah() *Jins {
+	return NewJins(JinsNameSikah, halftone.EDO24, 3, 4)
+}
+
+func JinsNahawand() *Jins {
+	return NewJins(JinsNameNahawand, halftone.EDO24, 4, 2, 4)
+}
+
+func JinsAjam() *Jins {
+	return NewJins(JinsNameAjam, halftone.EDO24, 4, 4, 2)
+}
+
+func JinsKurd() *Jins {
+	return NewJins(JinsNameKurd, halftone.EDO24, 2, 4, 4)
+}
+
+func JinsNikriz() *Jins {
+	return NewJins(JinsNameNikriz, halftone.EDO24, 4, 2, 6, 2)
+}
+
+// Turkish çeşniler
+
+func JinsCesniRast() *Jins {
+	return NewJins(JinsNameCesniRast, halftone.EDO53, 9, 8, 5)
+}
+
+func JinsCesniUssak() *Jins {
+	return NewJins(JinsNameCesniUssak, halftone.EDO53, 8, 5, 9)
+}
+
+func JinsCesniHicaz() *Jins {
+	return NewJins(JinsNameCesniHicaz, halftone.EDO53, 5, 12, 5)
+}
+
+func JinsCesniBuselik() *Jins {
+	return NewJins(JinsNameCesniBuselik, halftone.EDO53, 9, 4, 9, 9)
+}
+
+func JinsCesniKurdi() *Jins {
+	return NewJins(JinsNameCesniKurdi, halftone.EDO53, 4, 9, 9)
+}
+
+// GetJinsByName returns jins by its name.
+func GetJinsByName(name JinsName) (*Jins, error) {
+	getJins, ok := getAjnas()[name]
+	if !ok {
+		return nil, fmt.Errorf("got: '%s': %w", name, ErrJinsNameUnknown)
+	}
+
+	return getJins(), nil
+}
+
+// getAjnas returns all the known ajnas by their names.
+func getAjnas() map[JinsName]func() *Jins {
+	return map[JinsName]func() *Jins{
+		JinsNameRast:     JinsRast,
+		JinsNameBayati:   JinsBayati,
+		JinsNameHijaz:    JinsHijaz,
+		JinsNameSaba:     JinsSaba,
+		JinsNameSikah:    JinsSikah,
+		JinsNameNahawand: JinsNahawand,
+		JinsNameAjam:     JinsAjam,
+		JinsNameKurd:     JinsKurd,
+		JinsNameNikriz:   JinsNikriz,
+
+		JinsNameCesniRast:    JinsCesniRast,
+		JinsNameCesniUssak:   JinsCesniUssak,
+		JinsNameCesniHicaz:   JinsCesniHicaz,
+		JinsNameCesniBuselik: JinsCesniBuselik,
+		JinsNameCesniKurdi:   JinsCesniKurdi,
+	}
+}
@@ -0,0 +1,54 @@
+package maqam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+)
+
+func TestJins(t *testing.T) {
+	testCases := []struct {
+		jins   *Jins
+		length int
+		span   halftone.HalfTones
+	}{
+		{jins: JinsRast(), length: 4, span: 10},
+		{jins: JinsSikah(), length: 3, span: 7},
+		{jins: JinsNikriz(), length: 5, span: 14},
+		{jins: JinsCesniBuselik(), length: 5, span: 31},
+		{jins: JinsCesniHicaz(), length: 4, span: 22},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.jins.Name()), func(t *testing.T) {
+			assert.Equal(t, testCase.length, testCase.jins.Length())
+			assert.Equal(t, testCase.span, testCase.jins.Span())
+		})
+	}
+
+	jins := JinsBayati()
+	jins.Template()[0] = 1
+	assert.Equal(t, mode.Template{3, 3, 4}, jins.Template())
+
+	var nilJins *Jins
+	assert.Empty(t, nilJins.Name())
+	assert.Zero(t, nilJins.EDO())
+	assert.Nil(t, nilJins.Template())
+	assert.Zero(t, nilJins.Length())
+	assert.Zero(t, nilJins.Span())
+}
+
+func TestGetJinsByName(t *testing.T) {
+	for name := range getAjnas() {
+		jins, err := GetJinsByName(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, jins.Name())
+	}
+
+	_, err := GetJinsByName("unknown")
+	require.ErrorIs(t, err, ErrJinsNameUnknown)
+}
@@ -0,0 +1,219 @@
+package maqam
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+// Name is a name of a maqam.
+type Name string
+
+// ErrNameUnknown is returned when maqam name is unknown.
+var ErrNameUnknown = errors.New("unknown maqam name")
+
+// ErrJinsNameUnknown is returned when jins name is unknown.
+var ErrJinsNameUnknown = errors.New("unknown jins name")
+
+// ErrInvalidMaqam is returned when ajnas of the maqam don't make a valid mode.
+var ErrInvalidMaqam = errors.New("invalid maqam")
+
+// Placement is a jins placed on a certain distance in steps from the tonic of the maqam.
+type Placement struct {
+	Jins   *Jins
+	Offset halftone.HalfTones
+}
+
+// Maqam is a mode composed of ajnas placed one after another within the octave.
+type Maqam struct {
+	name  Name
+	edo   halftone.EDO
+	ajnas []Placement
+}
+
+// New creates a maqam from the ajnas placed from its tonic. All the ajnas must have the same division of the octave.
+// Notes of the ajnas beyond the octave are ignored.
+func New(name Name, ajnas ...Placement) (*Maqam, error) {
+	if len(ajnas) == 0 || ajnas[0].Jins == nil {
+		return nil, fmt.Errorf("maqam '%s' has no ajnas: %w", name, ErrInvalidMaqam)
+	}
+
+	edo := ajnas[0].Jins.EDO()
+	for _, placement := range ajnas {
+		if placement.Jins.EDO() != edo {
+			return nil, fmt.Errorf("jins '%s' of maqam '%s' is in %d-EDO, expected %d-EDO: %w",
+				placement.Jins.Name(), name, placement.Jins.EDO(), edo, ErrInvalidMaqam)
+		}
+	}
+
+	m := &Maqam{name: name, edo: edo, ajnas: ajnas}
+	if err := m.Template().ValidateEDO(edo); err != nil {
+		return nil, fmt.Errorf("validate template of maqam '%s': %w", name, err)
+	}
+
+	return m, nil
+}
+
+// MustNew creates a maqam as New does, if you are confident in the correctness of the ajnas.
+func MustNew(name Name, ajnas ...Placement) *Maqam {
+	m, err := New(name, ajnas...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Name returns the name of the maqam.
+func (m *Maqam) Name() Name {
+	if m == nil {
+		return ""
+	}
+
+	return m.name
+}
+
+// EDO returns the division of the octave the steps of the maqam are counted in.
+func (m *Maqam) EDO() halftone.EDO {
+	if m == nil {
+		return 0
+	}
+
+	return m.edo
+}
+
+// Ajnas returns the ajnas of the maqam with their distances from the tonic.
+func (m *Maqam) Ajnas() []Placement {
+	if m == nil {
+		return nil
+	}
+
+	ajnas := make([]Placement, len(m.ajnas))
+	copy(ajnas, m.ajnas)
+
+	return ajnas
+}
+
+// positions returns sorted distances in steps from the tonic to each note of the maqam within the octave.
+func (m *Maqam) positions() []int {
+	octaveSteps := int(m.edo)
+	unique := map[int]struct{}{0: {}}
+	for _, placement := range m.ajnas {
+		for _, position := range placement.Jins.positions() {
+			if position += int(placement.Offset); position < octaveSteps {
+				unique[position] = struct{}{}
+			}
+		}
+	}
+
+	positions := make([]int, 0, len(unique))
+	for position := range unique {
+		positions = append(positions, position)
+	}
+	sort.Ints(positions)
+
+	return positions
+}
+
+// Template returns steps of the division of the octave between the degrees of the maqam.
+func (m *Maqam) Template() mode.Template {
+	if m == nil {
+		return nil
+	}
+
+	positions := append(m.positions(), int(m.edo))
+	template := make(mode.Template, 0, len(positions)-1)
+	for i := 1; i < len(positions); i++ {
+		template = append(template, halftone.HalfTones(positions[i]-positions[i-1])) //nolint:gosec // steps within octave
+	}
+
+	return template
+}
+
+// StepMode returns the maqam as a mode of its division of the octave.
+func (m *Maqam) StepMode() (*mode.StepMode, error) {
+	return mode.NewStepMode(mode.Name(m.Name()), m.EDO(), m.Template())
+}
+
+// Frequencies returns frequencies of the degrees of the maqam built from the given frequency of the tonic.
+func (m *Maqam) Frequencies(tonicFrequency float64) ([]float64, error) {
+	stepMode, err := m.StepMode()
+	if err != nil {
+		return nil, err
+	}
+
+	return stepMode.Frequencies(tonicFrequency), nil
+}
+
+// getBaseNamePositions returns positions of the natural notes from C in quarter tones.
+func getBaseNamePositions() map[note.Name]int {
+	return map[note.Name]int{
+		note.C: 0,
+		note.D: 4,  //nolint:mnd
+		note.E: 8,  //nolint:mnd
+		note.F: 10, //nolint:mnd
+		note.G: 14, //nolint:mnd
+		note.A: 18, //nolint:mnd
+		note.B: 22, //nolint:mnd
+	}
+}
+
+// Notes builds notes of the maqam from the tonic.
+// Each degree gets the next letter name with the sharps, flats and quarter-tone accidentals it needs,
+// e.g. maqam Rast from C is C D Ed F G A Bd.
+// Divisions of the octave other than 24-EDO are approximated by the nearest quarter tones.
+// If the tonic has an octave, the notes get octaves too, the octave changes at C.
+func (m *Maqam) Notes(tonic *note.Note) (note.Notes, error) {
+	if m == nil || tonic == nil {
+		return nil, fmt.Errorf("build notes of maqam: %w", ErrInvalidMaqam)
+	}
+
+	positions := m.positions()
+	if len(positions) != int(mode.DegreesInHeptatonic) {
+		return nil, fmt.Errorf("maqam '%s' has %d degrees, only heptatonic maqamat can be spelled: %w", m.name, len(positions), ErrInvalidMaqam)
+	}
+
+	letters := note.Names{note.C, note.D, note.E, note.F, note.G, note.A, note.B}
+	basePositions := getBaseNamePositions()
+	const quarterTonesInOctave = int(halftone.EDO24)
+
+	var tonicIndex int
+	for i, letter := range letters {
+		if letter == tonic.BaseName() {
+			tonicIndex = i
+		}
+	}
+	tonicPosition := basePositions[tonic.BaseName()] + int(tonic.GetAlterationShiftInQuarterTones())
+
+	notes := make(note.Notes, 0, len(positions))
+	for i, position := range positions {
+		letterIndex := tonicIndex + i
+		letter := letters[letterIndex%len(letters)]
+		octaveShift := letterIndex / len(letters)
+
+		quarterTones := tonicPosition + halftone.EDO24.NearestSteps(m.edo.Cents(position))
+		alteration := quarterTones - basePositions[letter] - octaveShift*quarterTonesInOctave
+
+		n, err := note.New(letter + note.Name(note.NewAccidentalByQuarterTones(int8(alteration)))) //nolint:gosec // alterations are small
+		if err != nil {
+			return nil, fmt.Errorf("build degree %d of maqam '%s': %w", i+1, m.name, err)
+		}
+
+		if tonic.Octave() != nil {
+			oct, err := octave.NewByNumber(tonic.Octave().Number() + octave.Number(octaveShift)) //nolint:gosec // octave shift is 0 or 1
+			if err != nil {
+				return nil, fmt.Errorf("build octave of degree %d of maqam '%s': %w", i+1, m.name, err)
+			}
+			n.SetOctave(oct)
+		}
+
+		notes = append(notes, n)
+	}
+
+	return notes, nil
+}
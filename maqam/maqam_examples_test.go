@@ -0,0 +1,26 @@
+package maqam_test
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/maqam"
+	"github.com/go-muse/muse/note"
+)
+
+// Maqam Rast is built from two Rast tetrachords: on the tonic and on the fifth.
+func ExampleMaqam_Notes() {
+	rast := maqam.MaqamRast()
+	for _, placement := range rast.Ajnas() {
+		fmt.Println(placement.Jins.Name(), placement.Jins.Template(), placement.Offset)
+	}
+
+	notes, err := rast.Notes(note.C.MustNewNote())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(rast.Template(), notes)
+	// Output: Rast [4 3 3] 0
+	// Rast [4 3 3] 14
+	// [4 3 3 4 4 3 3] [C D Ed F G A Bd]
+}
@@ -0,0 +1,141 @@
+package maqam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestMaqamTemplates(t *testing.T) {
+	testCases := []struct {
+		maqam    *Maqam
+		expected mode.Template
+	}{
+		{maqam: MaqamRast(), expected: mode.Template{4, 3, 3, 4, 4, 3, 3}},
+		{maqam: MaqamBayati(), expected: mode.Template{3, 3, 4, 4, 2, 4, 4}},
+		{maqam: MaqamHijaz(), expected: mode.Template{2, 6, 2, 4, 2, 4, 4}},
+		{maqam: MaqamSaba(), expected: mode.Template{3, 3, 2, 6, 2, 4, 4}},
+		{maqam: MaqamSikah(), expected: mode.Template{3, 4, 4, 3, 3, 4, 3}},
+		{maqam: MaqamNahawand(), expected: mode.Template{4, 2, 4, 4, 2, 6, 2}},
+		{maqam: MaqamKurd(), expected: mode.Template{2, 4, 4, 4, 2, 4, 4}},
+		{maqam: MaqamAjam(), expected: mode.Template{4, 4, 2, 4, 4, 4, 2}},
+		{maqam: MaqamNikriz(), expected: mode.Template{4, 2, 6, 2, 4, 2, 4}},
+
+		{maqam: MakamRast(), expected: mode.Template{9, 8, 5, 9, 9, 8, 5}},
+		{maqam: MakamUssak(), expected: mode.Template{8, 5, 9, 9, 4, 9, 9}},
+		{maqam: MakamHicaz(), expected: mode.Template{5, 12, 5, 9, 8, 5, 9}},
+		{maqam: MakamBuselik(), expected: mode.Template{9, 4, 9, 9, 4, 9, 9}},
+		{maqam: MakamKurdi(), expected: mode.Template{4, 9, 9, 9, 4, 9, 9}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.maqam.Name()), func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.maqam.Template())
+			require.NoError(t, testCase.maqam.Template().ValidateEDO(testCase.maqam.EDO()))
+		})
+	}
+}
+
+func TestMaqamNotes(t *testing.T) {
+	testCases := []struct {
+		maqam    *Maqam
+		tonic    note.Name
+		expected note.Names
+	}{
+		{maqam: MaqamRast(), tonic: note.C, expected: note.Names{"C", "D", "Ed", "F", "G", "A", "Bd"}},
+		{maqam: MaqamRast(), tonic: note.G, expected: note.Names{"G", "A", "Bd", "C", "D", "E", "F+"}},
+		{maqam: MaqamBayati(), tonic: note.D, expected: note.Names{"D", "Ed", "F", "G", "A", "Bb", "C"}},
+		{maqam: MaqamHijaz(), tonic: note.D, expected: note.Names{"D", "Eb", "F#", "G", "A", "Bb", "C"}},
+		{maqam: MaqamSaba(), tonic: note.D, expected: note.Names{"D", "Ed", "F", "Gb", "A", "Bb", "C"}},
+		{maqam: MaqamSikah(), tonic: note.EHALFFLAT, expected: note.Names{"Ed", "F", "G", "A", "Bd", "C", "D"}},
+		{maqam: MaqamBayati(), tonic: note.EHALFFLAT, expected: note.Names{"Ed", "F", "Gd", "Ad", "Bd", "Cd", "Dd"}},
+		{maqam: MaqamRast(), tonic: note.BFLAT, expected: note.Names{"Bb", "C", "Dd", "Eb", "F", "G", "Ad"}},
+		{maqam: MakamRast(), tonic: note.G, expected: note.Names{"G", "A", "B", "C", "D", "E", "F#"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.maqam.Name())+" from "+string(testCase.tonic), func(t *testing.T) {
+			notes, err := testCase.maqam.Notes(note.MustNewNote(testCase.tonic))
+			require.NoError(t, err)
+
+			names := make(note.Names, 0, len(notes))
+			for _, n := range notes {
+				names = append(names, n.Name())
+			}
+			assert.Equal(t, testCase.expected, names)
+		})
+	}
+
+	t.Run("notes with octaves", func(t *testing.T) {
+		notes, err := MaqamRast().Notes(note.MustNewNoteWithOctave(note.G, octave.Number3))
+		require.NoError(t, err)
+
+		expectedOctaves := []octave.Number{3, 3, 3, 4, 4, 4, 4}
+		for i, n := range notes {
+			assert.Equal(t, expectedOctaves[i], n.Octave().Number(), "note: %s", n.Name())
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := MaqamRast().Notes(nil)
+		require.ErrorIs(t, err, ErrInvalidMaqam)
+
+		pentatonic := MustNew("Pentatonic", Placement{NewJins("Major", halftone.EDO24, 4, 4, 6), 0}, Placement{NewJins("Minor", halftone.EDO24, 4, 6), 14})
+		_, err = pentatonic.Notes(note.MustNewNote(note.C))
+		require.ErrorIs(t, err, ErrInvalidMaqam)
+
+		_, err = MaqamRast().Notes(note.MustNewNoteWithOctave(note.G, octave.Number9))
+		require.ErrorIs(t, err, octave.ErrOctaveNumberUnknown)
+	})
+}
+
+func TestNewMaqam(t *testing.T) {
+	_, err := New("Empty")
+	require.ErrorIs(t, err, ErrInvalidMaqam)
+
+	_, err = New("Mixed", Placement{JinsRast(), 0}, Placement{JinsCesniRast(), 31})
+	require.ErrorIs(t, err, ErrInvalidMaqam)
+
+	_, err = New("Zero", Placement{NewJins("Zero", 0, 1, 2), 0})
+	require.ErrorIs(t, err, mode.ErrInvalidModeTemplate)
+
+	assert.Panics(t, func() { MustNew("Zero", Placement{NewJins("Zero", 0, 1, 2), 0}) })
+
+	m := MaqamSaba()
+	ajnas := m.Ajnas()
+	require.Len(t, ajnas, 3)
+	assert.Equal(t, JinsNameHijaz, ajnas[1].Jins.Name())
+	assert.Equal(t, halftone.HalfTones(6), ajnas[1].Offset)
+}
+
+func TestMaqamFrequencies(t *testing.T) {
+	frequencies, err := MaqamRast().Frequencies(note.MustNewNoteWithOctave(note.C, octave.Number4).FrequencyBy440())
+	require.NoError(t, err)
+	require.Len(t, frequencies, 7)
+
+	ehalfflat := note.MustNewNoteWithOctave(note.EHALFFLAT, octave.Number4)
+	assert.InEpsilon(t, ehalfflat.FrequencyBy440(), frequencies[2], 0.000001)
+
+	var m *Maqam
+	_, err = m.Frequencies(440)
+	require.ErrorIs(t, err, halftone.ErrInvalidEDO)
+}
+
+func TestGetByName(t *testing.T) {
+	for _, name := range Names() {
+		m, err := GetByName(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, m.Name())
+	}
+
+	assert.Len(t, Names(), 14)
+
+	_, err := GetByName("unknown")
+	require.ErrorIs(t, err, ErrNameUnknown)
+}
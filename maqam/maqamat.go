@@ -0,0 +1,132 @@
+package maqam
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// Arabic maqamat.
+
+	NameRast     = Name("Rast")
+	NameBayati   = Name("Bayati")
+	NameHijaz    = Name("Hijaz")
+	NameSaba     = Name("Saba")
+	NameSikah    = Name("Sikah")
+	NameNahawand = Name("Nahawand")
+	NameKurd     = Name("Kurd")
+	NameAjam     = Name("Ajam")
+	NameNikriz   = Name("Nikriz")
+
+	// Turkish makamlar.
+
+	NameMakamRast    = Name("MakamRast")
+	NameMakamUssak   = Name("MakamUssak")
+	NameMakamHicaz   = Name("MakamHicaz")
+	NameMakamBuselik = Name("MakamBuselik")
+	NameMakamKurdi   = Name("MakamKurdi")
+)
+
+// Arabic maqamat
+
+func MaqamRast() *Maqam {
+	return MustNew(NameRast, Placement{JinsRast(), 0}, Placement{JinsRast(), 14})
+}
+
+func MaqamBayati() *Maqam {
+	return MustNew(NameBayati, Placement{JinsBayati(), 0}, Placement{JinsNahawand(), 10})
+}
+
+func MaqamHijaz() *Maqam {
+	return MustNew(NameHijaz, Placement{JinsHijaz(), 0}, Placement{JinsNahawand(), 10})
+}
+
+func MaqamSaba() *Maqam {
+	return MustNew(NameSaba, Placement{JinsSaba(), 0}, Placement{JinsHijaz(), 6}, Placement{JinsAjam(), 16})
+}
+
+func MaqamSikah() *Maqam {
+	return MustNew(NameSikah, Placement{JinsSikah(), 0}, Placement{JinsRast(), 7}, Placement{JinsRast(), 17})
+}
+
+func MaqamNahawand() *Maqam {
+	return MustNew(NameNahawand, Placement{JinsNahawand(), 0}, Placement{JinsHijaz(), 14})
+}
+
+func MaqamKurd() *Maqam {
+	return MustNew(NameKurd, Placement{JinsKurd(), 0}, Placement{JinsNahawand(), 10})
+}
+
+func MaqamAjam() *Maqam {
+	return MustNew(NameAjam, Placement{JinsAjam(), 0}, Placement{JinsAjam(), 14})
+}
+
+func MaqamNikriz() *Maqam {
+	return MustNew(NameNikriz, Placement{JinsNikriz(), 0}, Placement{JinsNahawand(), 14})
+}
+
+// Turkish makamlar
+
+func MakamRast() *Maqam {
+	return MustNew(NameMakamRast, Placement{JinsCesniRast(), 0}, Placement{JinsCesniRast(), 31})
+}
+
+func MakamUssak() *Maqam {
+	return MustNew(NameMakamUssak, Placement{JinsCesniUssak(), 0}, Placement{JinsCesniBuselik(), 22})
+}
+
+func MakamHicaz() *Maqam {
+	return MustNew(NameMakamHicaz, Placement{JinsCesniHicaz(), 0}, Placement{JinsCesniRast(), 22})
+}
+
+func MakamBuselik() *Maqam {
+	return MustNew(NameMakamBuselik, Placement{JinsCesniBuselik(), 0}, Placement{JinsCesniKurdi(), 31})
+}
+
+func MakamKurdi() *Maqam {
+	return MustNew(NameMakamKurdi, Placement{JinsCesniKurdi(), 0}, Placement{JinsCesniBuselik(), 22})
+}
+
+// getMaqamat returns all the known maqamat by their names.
+func getMaqamat() map[Name]func() *Maqam {
+	return map[Name]func() *Maqam{
+		NameRast:     MaqamRast,
+		NameBayati:   MaqamBayati,
+		NameHijaz:    MaqamHijaz,
+		NameSaba:     MaqamSaba,
+		NameSikah:    MaqamSikah,
+		NameNahawand: MaqamNahawand,
+		NameKurd:     MaqamKurd,
+		NameAjam:     MaqamAjam,
+		NameNikriz:   MaqamNikriz,
+
+		NameMakamRast:    MakamRast,
+		NameMakamUssak:   MakamUssak,
+		NameMakamHicaz:   MakamHicaz,
+		NameMakamBuselik: MakamBuselik,
+		NameMakamKurdi:   MakamKurdi,
+	}
+}
+
+// GetByName returns maqam by its name.
+func GetByName(name Name) (*Maqam, error) {
+	getMaqam, ok := getMaqamat()[name]
+	if !ok {
+		return nil, fmt.Errorf("got: '%s': %w", name, ErrNameUnknown)
+	}
+
+	return getMaqam(), nil
+}
+
+// Names returns sorted names of all the known maqamat.
+func Names() []Name {
+	maqamat := getMaqamat()
+	names := make([]Name, 0, len(maqamat))
+	for name := range maqamat {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	return names
+}
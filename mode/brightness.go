@@ -0,0 +1,123 @@
+package mode
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-muse/muse/degree"
+)
+
+// Brightness returns the sum of halftones from the tonic to each degree of the heptatonic template.
+// The more degrees are raised, the brighter the mode is: Lydian is the brightest mode of the major scale, and Locrian is the darkest one.
+func (t Template) Brightness() (int, error) {
+	if !t.IsHeptatonic() {
+		return 0, fmt.Errorf("brightness of template with %d degrees: %w", t.Length(), ErrInvalidModeTemplate)
+	}
+
+	var brightness int
+	for degreeNum := degree.Number(1); degreeNum < t.Length(); degreeNum++ {
+		brightness += int(t.GetHalftonesByDegreeNum(degreeNum))
+	}
+
+	return brightness, nil
+}
+
+// SortByBrightness sorts the slice with mode names and templates by brightness of the templates,
+// from the brightest mode to the darkest one if desc is true. Modes of the same brightness are sorted by name.
+// Non-heptatonic templates go last.
+func (nat NamesAndTemplates) SortByBrightness(desc bool) NamesAndTemplates {
+	sort.SliceStable(nat, func(i, j int) bool {
+		brightnessI, errI := nat[i].ModeTemplate.Brightness()
+		brightnessJ, errJ := nat[j].ModeTemplate.Brightness()
+
+		switch {
+		case errI != nil || errJ != nil:
+			return errI == nil && errJ != nil
+		case brightnessI != brightnessJ && desc:
+			return brightnessI > brightnessJ
+		case brightnessI != brightnessJ:
+			return brightnessI < brightnessJ
+		}
+
+		return nat[i].Name < nat[j].Name
+	})
+
+	return nat
+}
+
+// Alteration is a template got from another one by raising or lowering one of its degrees by a halftone.
+type Alteration struct {
+	Degree   degree.Number
+	Raised   bool
+	Template Template
+	// Characteristic is the modal characteristic of the altered degree relative to the tonic, e.g. Aug for the raised fourth degree.
+	// It's set for heptatonic templates only.
+	Characteristic degree.CharacteristicName
+	// Names are the names of the modes of the store having the template.
+	Names []Name
+}
+
+// String returns the alteration in the form "#4" or "b7".
+func (a Alteration) String() string {
+	if a.Raised {
+		return fmt.Sprintf("#%d", a.Degree)
+	}
+
+	return fmt.Sprintf("b%d", a.Degree)
+}
+
+// Alterations returns all the templates got by raising or lowering one degree of the template by a halftone.
+// The tonic is never altered, and the degrees can't coincide with their neighbours.
+func (t Template) Alterations() []Alteration {
+	alterations := make([]Alteration, 0)
+	if t.Validate() != nil {
+		return alterations
+	}
+
+	for degreeNum := degree.Number(2); degreeNum <= t.Length(); degreeNum++ {
+		for _, raised := range []bool{false, true} {
+			before, after := t[degreeNum-2], t[degreeNum-1]
+			if raised {
+				before, after = before+1, after-1
+			} else {
+				before, after = before-1, after+1
+			}
+
+			if before == 0 || after == 0 {
+				continue
+			}
+
+			altered := make(Template, len(t))
+			copy(altered, t)
+			altered[degreeNum-2], altered[degreeNum-1] = before, after
+
+			alteration := Alteration{Degree: degreeNum, Raised: raised, Template: altered}
+			if altered.IsHeptatonic() {
+				mc, err := degree.CalculateRelativeMC(degreeNum, nil, altered.GetHalftonesByDegreeNum(degreeNum-1))
+				if err == nil {
+					alteration.Characteristic = mc.Name()
+				}
+			}
+
+			alterations = append(alterations, alteration)
+		}
+	}
+
+	return alterations
+}
+
+// Alterations returns all the templates got by raising or lowering one degree of the template by a halftone
+// with the names of the modes of the store having the same templates, so Ionian gives Lydian by #4 and MixoLydian by b7.
+func (ts TemplatesStore) Alterations(t Template) []Alteration {
+	alterations := t.Alterations()
+	nats := ts.AsSlice().SortByName(false)
+	for i := range alterations {
+		for _, nat := range nats {
+			if nat.ModeTemplate.IsEqual(alterations[i].Template) {
+				alterations[i].Names = append(alterations[i].Names, nat.Name)
+			}
+		}
+	}
+
+	return alterations
+}
@@ -0,0 +1,94 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+)
+
+func TestTemplate_Brightness(t *testing.T) {
+	testCases := []struct {
+		template   Template
+		brightness int
+	}{
+		{template: TemplateLydian(), brightness: 39},
+		{template: TemplateIonian(), brightness: 38},
+		{template: TemplateMixoLydian(), brightness: 37},
+		{template: TemplateDorian(), brightness: 36},
+		{template: TemplateAeolian(), brightness: 35},
+		{template: TemplatePhrygian(), brightness: 34},
+		{template: TemplateLocrian(), brightness: 33},
+		{template: TemplateLydianAugmented(), brightness: 40},
+		{template: TemplateUltraLocrian(), brightness: 31},
+	}
+
+	for _, testCase := range testCases {
+		brightness, err := testCase.template.Brightness()
+		require.NoError(t, err)
+		assert.Equal(t, testCase.brightness, brightness, testCase.template)
+	}
+
+	_, err := TemplatePentatonicMajor().Brightness()
+	require.ErrorIs(t, err, ErrInvalidModeTemplate)
+}
+
+func TestNamesAndTemplates_SortByBrightness(t *testing.T) {
+	nat := NamesAndTemplates{
+		{NameDorian, TemplateDorian()},
+		{NamePentatonicMajor, TemplatePentatonicMajor()},
+		{NameLocrian, TemplateLocrian()},
+		{NameNaturalMajor, TemplateNaturalMajor()},
+		{NameLydian, TemplateLydian()},
+		{NameIonian, TemplateIonian()},
+	}
+
+	names := func(nat NamesAndTemplates) []Name {
+		result := make([]Name, len(nat))
+		for i := range nat {
+			result[i] = nat[i].Name
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []Name{NameLydian, NameIonian, NameNaturalMajor, NameDorian, NameLocrian, NamePentatonicMajor}, names(nat.SortByBrightness(true)))
+	assert.Equal(t, []Name{NameLocrian, NameDorian, NameIonian, NameNaturalMajor, NameLydian, NamePentatonicMajor}, names(nat.SortByBrightness(false)))
+}
+
+func TestTemplate_Alterations(t *testing.T) {
+	alterations := TemplateIonian().Alterations()
+
+	// Each degree but the tonic can be raised or lowered unless it meets its neighbour
+	assert.Len(t, alterations, 9)
+
+	got := make([]string, 0, len(alterations))
+	for _, a := range alterations {
+		got = append(got, a.String())
+	}
+	assert.Equal(t, []string{"b2", "#2", "b3", "#4", "b5", "#5", "b6", "#6", "b7"}, got)
+
+	assert.Equal(t, TemplateLydian(), alterations[3].Template)
+	assert.Equal(t, degree.CharacteristicAug, alterations[3].Characteristic)
+	assert.Equal(t, degree.CharacteristicMinor, alterations[8].Characteristic)
+
+	assert.Empty(t, Template{1, 1}.Alterations())
+	assert.Len(t, TemplatePentatonicMajor().Alterations(), 8)
+	assert.Empty(t, TemplatePentatonicMajor().Alterations()[0].Characteristic)
+}
+
+func TestTemplatesStore_Alterations(t *testing.T) {
+	names := make(map[string][]Name)
+	for _, a := range InitTemplatesStore().Alterations(TemplateIonian()) {
+		names[a.String()] = a.Names
+	}
+
+	assert.Equal(t, []Name{NameLydian}, names["#4"])
+	assert.Equal(t, []Name{NameMixoLydian}, names["b7"])
+	assert.Equal(t, []Name{NameHarmonicMajor, NameIonianFlat6}, names["b6"])
+	assert.Equal(t, []Name{NameIonianFlat3, NameMelodicMinor}, names["b3"])
+	assert.Equal(t, []Name{NameIonianRais5}, names["#5"])
+	assert.Empty(t, names["b2"])
+}
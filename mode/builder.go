@@ -11,6 +11,8 @@ const (
 	DegreesInDiatonic   = degree.Number(7)
 	DegreesInHeptatonic = degree.Number(7)
 	DegreesInPentatonic = degree.Number(5)
+	DegreesInHexatonic  = degree.Number(6)
+	DegreesInOctatonic  = degree.Number(8)
 	DegreesInTonality   = degree.Number(17)
 )
 
@@ -53,10 +55,8 @@ func newModeBuilder(modeTemplate Template) *modeBuilder {
 	switch {
 	case modeTemplate.Length() == DegreesInHeptatonic:
 		return &modeBuilder{halftone.Template(modeTemplate), builder.NewBuilderHeptatonic}
-	case modeTemplate.Length() < DegreesInHeptatonic:
-		return &modeBuilder{halftone.Template(modeTemplate), builder.NewBuilderCommon}
 	default:
-		panic("Modes with eight or more steps are not yet supported.")
+		return &modeBuilder{halftone.Template(modeTemplate), builder.NewBuilderNonHeptatonic}
 	}
 }
 
@@ -0,0 +1,168 @@
+package mode
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+var (
+	// ErrNoteNotInMode is returned when the note is not found in the mode.
+	ErrNoteNotInMode = errors.New("note is not in the mode")
+	// ErrRepetitionsInvalid is returned when the sequence is asked to be repeated a negative amount of times.
+	ErrRepetitionsInvalid = errors.New("invalid amount of repetitions")
+)
+
+// OutOfModePolicy defines what to do with the notes that are not in the mode during diatonic transposition.
+type OutOfModePolicy uint8
+
+const (
+	// OutOfModeError makes diatonic transposition fail with ErrNoteNotInMode.
+	OutOfModeError OutOfModePolicy = iota
+	// OutOfModeSkip drops the notes that are not in the mode.
+	OutOfModeSkip
+	// OutOfModeKeep leaves the notes that are not in the mode as is.
+	OutOfModeKeep
+	// OutOfModeKeepAlteration moves the note as the degree with the same letter name and keeps its alteration,
+	// e.g. F# in C major moved up a third becomes A#.
+	OutOfModeKeepAlteration
+)
+
+// findDegreeByNote returns the degree of the mode containing the note with the same name.
+func (m *Mode) findDegreeByNote(n *note.Note) *degree.Degree {
+	for d := range m.IterateOneRound(false) {
+		if d.Note().IsEqualByName(n) {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// findDegreeByBaseName returns the degree of the mode containing the note with the same letter name.
+func (m *Mode) findDegreeByBaseName(n *note.Note) *degree.Degree {
+	for d := range m.IterateOneRound(false) {
+		if d.Note().BaseName() == n.BaseName() {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// stepsBetweenDegrees returns halftones and letter names from the degree to the degree the given amount of steps away.
+// Negative steps go down.
+func stepsBetweenDegrees(d *degree.Degree, steps int) (halfTones, letters int, err error) {
+	const lettersInOctave = 7
+	current := d
+	for ; steps != 0; steps = towardsZero(steps) {
+		next := current.GetForwardDegreeByDegreeNum(1)
+		if steps < 0 {
+			next = current.GetPrevious()
+		}
+
+		if next == nil || next == current {
+			return 0, 0, fmt.Errorf("step from degree %d: %w", current.Number(), ErrDegreeNumberInvalid)
+		}
+
+		lower, upper := current, next
+		if steps < 0 {
+			lower, upper = next, current
+		}
+
+		stepHalfTones := int(upper.HalfTonesFromPrime()) - int(lower.HalfTonesFromPrime())
+		if stepHalfTones <= 0 {
+			stepHalfTones += int(halftone.HalfTonesInOctave)
+		}
+
+		stepLetters := (upper.Note().BaseNameIndex() - lower.Note().BaseNameIndex() + lettersInOctave) % lettersInOctave
+		if steps < 0 {
+			stepHalfTones, stepLetters = -stepHalfTones, -stepLetters
+		}
+
+		halfTones += stepHalfTones
+		letters += stepLetters
+		current = next
+	}
+
+	return halfTones, letters, nil
+}
+
+// towardsZero returns the number moved by one towards zero.
+func towardsZero(i int) int {
+	if i > 0 {
+		return i - 1
+	}
+
+	return i + 1
+}
+
+// TransposeNotesDiatonically moves the notes by the given amount of steps of the mode (negative steps go down),
+// so the qualities of the intervals between the notes change according to the mode:
+// C E G moved up a second in C major is D F A, and up a third is E G B.
+// Octaves of the notes are changed when the notes pass C. The notes that are not in the mode are handled by the policy.
+func (m *Mode) TransposeNotesDiatonically(notes note.Notes, steps int, policy OutOfModePolicy) (note.Notes, error) {
+	if m.GetFirstDegree() == nil {
+		return nil, fmt.Errorf("transpose notes in mode '%s': %w", m.Name(), ErrDegreeNumberInvalid)
+	}
+
+	transposed := make(note.Notes, 0, len(notes))
+	for _, n := range notes {
+		d := m.findDegreeByNote(n)
+		if d == nil {
+			switch policy {
+			case OutOfModeSkip:
+				continue
+			case OutOfModeKeep:
+				transposed = append(transposed, n.Copy())
+
+				continue
+			case OutOfModeKeepAlteration:
+				d = m.findDegreeByBaseName(n)
+			case OutOfModeError:
+			}
+		}
+
+		if d == nil {
+			return nil, fmt.Errorf("transpose note '%s' in mode '%s': %w", n.Name(), m.Name(), ErrNoteNotInMode)
+		}
+
+		halfTones, letters, err := stepsBetweenDegrees(d, steps)
+		if err != nil {
+			return nil, fmt.Errorf("transpose note '%s' in mode '%s': %w", n.Name(), m.Name(), err)
+		}
+
+		transposedNote, err := n.TransposeBySpelling(halfTones, letters)
+		if err != nil {
+			return nil, fmt.Errorf("transpose note '%s' in mode '%s': %w", n.Name(), m.Name(), err)
+		}
+
+		transposed = append(transposed, transposedNote)
+	}
+
+	return transposed, nil
+}
+
+// GenerateSequence repeats the motif the given amount of times, each time moving it by the given amount of steps of the mode.
+// The result starts with the motif itself, e.g. the motif C D E in C major repeated three times by one step up is C D E D E F E F G.
+// Negative amount of repetitions gives ErrRepetitionsInvalid.
+func (m *Mode) GenerateSequence(motif note.Notes, steps, repetitions int, policy OutOfModePolicy) (note.Notes, error) {
+	if repetitions < 0 {
+		return nil, fmt.Errorf("generate sequence of %d repetitions: %w", repetitions, ErrRepetitionsInvalid)
+	}
+
+	sequence := make(note.Notes, 0, len(motif)*repetitions)
+	for i := range repetitions {
+		transposed, err := m.TransposeNotesDiatonically(motif, steps*i, policy)
+		if err != nil {
+			return nil, fmt.Errorf("generate repetition %d of sequence: %w", i+1, err)
+		}
+
+		sequence = append(sequence, transposed...)
+	}
+
+	return sequence, nil
+}
@@ -0,0 +1,130 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestMode_TransposeNotesDiatonically(t *testing.T) {
+	cMajor := MustMakeNewMode(NameNaturalMajor, note.C)
+
+	testCases := []struct {
+		mode   *Mode
+		notes  note.Notes
+		steps  int
+		policy OutOfModePolicy
+		want   note.Notes
+	}{
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.E, note.G), steps: 1,
+			want: note.MustNewNotesFromNoteNames(note.D, note.F, note.A),
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.E, note.G), steps: 2,
+			want: note.MustNewNotesFromNoteNames(note.E, note.G, note.B),
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.E, note.G), steps: -1,
+			want: note.MustNewNotesFromNoteNames(note.B, note.D, note.F),
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.E), steps: 7,
+			want: note.MustNewNotesFromNoteNames(note.C, note.E),
+		},
+		{
+			mode: cMajor,
+			notes: note.Notes{
+				note.MustNewNoteWithOctave(note.A, octave.Number4),
+				note.MustNewNoteWithOctave(note.B, octave.Number4),
+			},
+			steps: 2,
+			want: note.Notes{
+				note.MustNewNoteWithOctave(note.C, octave.Number5),
+				note.MustNewNoteWithOctave(note.D, octave.Number5),
+			},
+		},
+		{
+			mode: cMajor,
+			notes: note.Notes{
+				note.MustNewNoteWithOctave(note.E, octave.Number4),
+			},
+			steps: -10,
+			want: note.Notes{
+				note.MustNewNoteWithOctave(note.B, octave.Number2),
+			},
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.FSHARP, note.G), steps: 2, policy: OutOfModeSkip,
+			want: note.MustNewNotesFromNoteNames(note.E, note.B),
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.FSHARP, note.G), steps: 2, policy: OutOfModeKeep,
+			want: note.MustNewNotesFromNoteNames(note.E, note.FSHARP, note.B),
+		},
+		{
+			mode: cMajor, notes: note.MustNewNotesFromNoteNames(note.C, note.FSHARP, note.G), steps: 2, policy: OutOfModeKeepAlteration,
+			want: note.MustNewNotesFromNoteNames(note.E, note.ASHARP, note.B),
+		},
+		{
+			mode: MustMakeNewMode(NameHarmonicMinor, note.A), notes: note.MustNewNotesFromNoteNames(note.E, note.GSHARP), steps: 1,
+			want: note.MustNewNotesFromNoteNames(note.F, note.A),
+		},
+		{
+			mode: MustMakeNewMode(NamePentatonicMajor, note.C), notes: note.MustNewNotesFromNoteNames(note.C, note.D, note.A), steps: 2,
+			want: note.MustNewNotesFromNoteNames(note.E, note.G, note.D),
+		},
+	}
+
+	for _, testCase := range testCases {
+		transposed, err := testCase.mode.TransposeNotesDiatonically(testCase.notes, testCase.steps, testCase.policy)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, transposed)
+	}
+
+	_, err := cMajor.TransposeNotesDiatonically(note.MustNewNotesFromNoteNames(note.FSHARP), 1, OutOfModeError)
+	require.ErrorIs(t, err, ErrNoteNotInMode)
+
+	_, err = MustMakeNewMode(NamePentatonicMajor, note.C).
+		TransposeNotesDiatonically(note.MustNewNotesFromNoteNames(note.F), 1, OutOfModeKeepAlteration)
+	require.ErrorIs(t, err, ErrNoteNotInMode)
+
+	_, err = (*Mode)(nil).TransposeNotesDiatonically(note.MustNewNotesFromNoteNames(note.C), 1, OutOfModeError)
+	require.ErrorIs(t, err, ErrDegreeNumberInvalid)
+}
+
+func TestMode_GenerateSequence(t *testing.T) {
+	cMajor := MustMakeNewMode(NameNaturalMajor, note.C)
+
+	sequence, err := cMajor.GenerateSequence(note.MustNewNotesFromNoteNames(note.C, note.D, note.E), 1, 3, OutOfModeError)
+	require.NoError(t, err)
+	assert.Equal(t, note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.D, note.E, note.F, note.E, note.F, note.G), sequence)
+
+	sequence, err = cMajor.GenerateSequence(note.Notes{
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+	}, -1, 3, OutOfModeError)
+	require.NoError(t, err)
+	assert.Equal(t, note.Notes{
+		note.MustNewNoteWithOctave(note.G, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.F, octave.Number4),
+		note.MustNewNoteWithOctave(note.D, octave.Number4),
+		note.MustNewNoteWithOctave(note.E, octave.Number4),
+		note.MustNewNoteWithOctave(note.C, octave.Number4),
+	}, sequence)
+
+	sequence, err = cMajor.GenerateSequence(note.MustNewNotesFromNoteNames(note.C), 1, 0, OutOfModeError)
+	require.NoError(t, err)
+	assert.Empty(t, sequence)
+
+	_, err = cMajor.GenerateSequence(note.MustNewNotesFromNoteNames(note.CSHARP), 1, 2, OutOfModeError)
+	require.ErrorIs(t, err, ErrNoteNotInMode)
+
+	_, err = cMajor.GenerateSequence(note.MustNewNotesFromNoteNames(note.C), 1, -1, OutOfModeError)
+	require.ErrorIs(t, err, ErrRepetitionsInvalid)
+}
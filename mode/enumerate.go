@@ -0,0 +1,103 @@
+package mode
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+)
+
+// EnumeratedTemplate is a template got by the enumeration with the names of the modes of the store
+// which templates are its rotations.
+type EnumeratedTemplate struct {
+	Template Template
+	Names    []Name
+}
+
+// IsNamed checks if any mode of the store is a rotation of the template.
+func (et EnumeratedTemplate) IsNamed() bool {
+	return len(et.Names) > 0
+}
+
+// canonicalRotation returns the rotation of the template going first in lexicographic order.
+func (t Template) canonicalRotation() Template {
+	canonical := t
+	for _, rotation := range t.Rotations() {
+		if compareTemplates(rotation, canonical) < 0 {
+			canonical = rotation
+		}
+	}
+
+	return canonical
+}
+
+// compareTemplates compares templates of the same length in lexicographic order.
+func compareTemplates(a, b Template) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return int(a[i]) - int(b[i])
+		}
+	}
+
+	return 0
+}
+
+// EnumerateTemplates returns channel streaming all the twelve-tone templates with the given amount of degrees
+// and the steps not greater than maxStep. Rotations of the same template are sent once, as the rotation going first
+// in lexicographic order, e.g. the major scale and its modes are sent as [1 2 2 1 2 2 2] with the names of all the modes of the store
+// being its rotations. Zero degrees means all the amounts of degrees from 1 to 12, so all the sets of pitch classes containing the tonic are enumerated.
+// Templates are generated lazily in lexicographic order, the channel is closed after the last one.
+func (ts TemplatesStore) EnumerateTemplates(degrees degree.Number, maxStep halftone.HalfTones) <-chan EnumeratedTemplate {
+	names := make(map[string][]Name)
+	for _, nat := range ts.AsSlice().SortByName(false) {
+		if nat.ModeTemplate.Validate() == nil {
+			key := fmt.Sprint(nat.ModeTemplate.canonicalRotation())
+			names[key] = append(names[key], nat.Name)
+		}
+	}
+
+	c := make(chan EnumeratedTemplate)
+	go func() {
+		defer close(c)
+
+		send := func(t Template) {
+			if compareTemplates(t.canonicalRotation(), t) == 0 {
+				c <- EnumeratedTemplate{Template: t, Names: names[fmt.Sprint(t)]}
+			}
+		}
+
+		if degrees != 0 {
+			enumerateTemplates(make(Template, 0, degrees), degrees, maxStep, halftone.HalfTonesInOctave, send)
+
+			return
+		}
+
+		for degreesNum := degree.Number(1); degreesNum <= degree.Number(halftone.HalfTonesInOctave); degreesNum++ {
+			enumerateTemplates(make(Template, 0, degreesNum), degreesNum, maxStep, halftone.HalfTonesInOctave, send)
+		}
+	}()
+
+	return c
+}
+
+// enumerateTemplates adds steps to the template recursively until it has all the degrees and fills the octave.
+func enumerateTemplates(t Template, degrees degree.Number, maxStep, halfTonesLeft halftone.HalfTones, send func(Template)) {
+	degreesLeft := degrees - t.Length()
+	if degreesLeft == 0 {
+		if halfTonesLeft == 0 {
+			send(append(Template{}, t...))
+		}
+
+		return
+	}
+
+	for step := halftone.HalfTones(1); step <= maxStep && step <= halfTonesLeft; step++ {
+		// The remaining degrees need at least a halftone each and can't exceed the maximum step
+		rest := halfTonesLeft - step
+		if int(rest) < int(degreesLeft-1) || int(rest) > int(degreesLeft-1)*int(maxStep) {
+			continue
+		}
+
+		enumerateTemplates(append(t, step), degrees, maxStep, rest, send)
+	}
+}
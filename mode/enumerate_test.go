@@ -0,0 +1,72 @@
+package mode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/halftone"
+)
+
+func collectTemplates(c <-chan EnumeratedTemplate) []EnumeratedTemplate {
+	result := make([]EnumeratedTemplate, 0)
+	for et := range c {
+		result = append(result, et)
+	}
+
+	return result
+}
+
+func TestTemplatesStore_EnumerateTemplates(t *testing.T) {
+	mts := InitTemplatesStore()
+
+	testCases := []struct {
+		degrees degree.Number
+		maxStep halftone.HalfTones
+		amount  int
+	}{
+		{degrees: 7, maxStep: 12, amount: 66},
+		{degrees: 7, maxStep: 3, amount: 38},
+		{degrees: 7, maxStep: 2, amount: 3},
+		{degrees: 5, maxStep: 12, amount: 66},
+		{degrees: 5, maxStep: 3, amount: 6},
+		{degrees: 6, maxStep: 2, amount: 1},
+		{degrees: 8, maxStep: 2, amount: 10},
+		{degrees: 12, maxStep: 1, amount: 1},
+		{degrees: 1, maxStep: 12, amount: 1},
+		{degrees: 0, maxStep: 12, amount: 351},
+		{degrees: 7, maxStep: 1, amount: 0},
+		{degrees: 13, maxStep: 12, amount: 0},
+	}
+
+	for _, testCase := range testCases {
+		result := collectTemplates(mts.EnumerateTemplates(testCase.degrees, testCase.maxStep))
+		assert.Len(t, result, testCase.amount, "degrees: %d, max step: %d", testCase.degrees, testCase.maxStep)
+
+		for i, et := range result {
+			require.NoError(t, et.Template.Validate())
+			assert.Equal(t, et.Template, et.Template.canonicalRotation())
+			if i > 0 && len(result[i-1].Template) == len(et.Template) {
+				assert.Negative(t, compareTemplates(result[i-1].Template, et.Template))
+			}
+		}
+	}
+
+	t.Run("named templates", func(t *testing.T) {
+		named := make(map[string][]Name)
+		for _, et := range collectTemplates(mts.EnumerateTemplates(7, 3)) {
+			if et.IsNamed() {
+				named[fmt.Sprint(et.Template)] = et.Names
+			}
+		}
+
+		assert.Len(t, named, 5)
+		assert.Contains(t, named[fmt.Sprint(TemplateLocrian())], NameIonian)
+		assert.Contains(t, named[fmt.Sprint(TemplateLocrian())], NameNaturalMinor)
+		assert.Len(t, named[fmt.Sprint(TemplateLocrian())], 9)
+		assert.Contains(t, named[fmt.Sprint(TemplateSuperLocrian())], NameMelodicMinor)
+	})
+}
@@ -0,0 +1,165 @@
+package mode
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-muse/muse/degree"
+)
+
+// Family is a name of a family of modes: the modes built from different degrees of the same scale.
+type Family string
+
+const (
+	FamilyMajor               = Family("Major")               // Modes of the Major scale
+	FamilyMelodicMinor        = Family("MelodicMinor")        // Modes Of The Melodic Minor scale
+	FamilyHarmonicMinor       = Family("HarmonicMinor")       // Modes of the Harmonic Minor scale
+	FamilyHarmonicMajor       = Family("HarmonicMajor")       // Modes Of The Harmonic Major scale
+	FamilyDoubleHarmonicMajor = Family("DoubleHarmonicMajor") // Double Harmonic Major Modes
+	FamilyPentatonic          = Family("Pentatonic")          // Main pentatonics
+	FamilyJapanesePentatonic  = Family("JapanesePentatonic")  // Japanese pentatonics
+)
+
+// ErrFamilyUnknown is returned when family name is unknown.
+var ErrFamilyUnknown = errors.New("unknown mode family")
+
+// getFamilies returns names of the modes of each family in the order of the degrees of the scale they are built from.
+func getFamilies() map[Family][]Name {
+	return map[Family][]Name{
+		FamilyMajor: {
+			NameIonian, NameDorian, NamePhrygian, NameLydian, NameMixoLydian, NameAeolian, NameLocrian,
+		},
+		FamilyMelodicMinor: {
+			NameIonianFlat3, NamePhrygoDorian, NameLydianAugmented, NameLydianDominant, NameIonianAeolian, NameAeolianLydian, NameSuperLocrian,
+		},
+		FamilyHarmonicMinor: {
+			NameAeolianRais7, NameLocrianRais6, NameIonianRais5, NameUkrainianDorian, NamePhrygianDominant, NameLydianRais9, NameUltraLocrian,
+		},
+		FamilyHarmonicMajor: {
+			NameIonianFlat6, NameDorianDiminished, NamePhrygianDiminished, NameLydianDiminished, NameMixolydianFlat2, NameLydianAugmented2, NameLocrianDoubleFlat7,
+		},
+		FamilyDoubleHarmonicMajor: {
+			NameHungarianMajor, NameLydianRais2Rais6, NameUltraPhrygian, NameHungarianMinor, NameOriental, NameIonianAugmented2, NameLocrianDoubleFlat3DoubleFlat7,
+		},
+		FamilyPentatonic: {
+			NamePentatonicMajor, NamePentatonicSustained, NamePentatonicBluesMinor, NamePentatonicBluesMajor, NamePentatonicMinor,
+		},
+		FamilyJapanesePentatonic: {
+			NamePentatonicHirajoshi, NamePentatonicIwato, NamePentatonicHonKumoiShiouzhi, NamePentatonicHonKumoiJoshi, NamePentatonicLydianPentatonic,
+		},
+	}
+}
+
+// GetFamilyByName returns names of the modes of the family in the order of the degrees of the scale they are built from,
+// e.g. Ionian, Dorian, Phrygian, Lydian, MixoLydian, Aeolian and Locrian for the major family.
+func GetFamilyByName(family Family) ([]Name, error) {
+	names, ok := getFamilies()[family]
+	if !ok {
+		return nil, fmt.Errorf("got: '%s': %w", family, ErrFamilyUnknown)
+	}
+
+	return names, nil
+}
+
+// Family returns the known family of modes the template belongs to
+// and the number of the degree of the family's first mode the template is built from.
+// E.g. the template of Dorian mode (and of any other mode with the same steps) is the second degree of the major family.
+// It returns false if the template is not a mode of any known family.
+func (t Template) Family() (Family, degree.Number, bool) {
+	for family, names := range getFamilies() {
+		parent, err := GetTemplateByName(names[0])
+		if err != nil {
+			continue
+		}
+
+		if degreeNum, ok := t.RotationOf(parent); ok {
+			return family, degreeNum, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// Rotation is a template rebuilt from a degree of another template with the names of the modes having the same steps.
+type Rotation struct {
+	Degree   degree.Number
+	Template Template
+	Names    []Name
+}
+
+// Rotations returns all the rotations of the template, naming them by the modes of the store having the same steps.
+// Names of each rotation are sorted, rotations without known modes have no names.
+func (ts TemplatesStore) Rotations(t Template) []Rotation {
+	rotations := make([]Rotation, 0, t.Length())
+	for i, rotated := range t.Rotations() {
+		rotation := Rotation{Degree: degree.Number(i + 1), Template: rotated} //nolint:gosec // amount of degrees is small
+		for _, nat := range ts.AsSlice().SortByName(false) {
+			if nat.ModeTemplate.IsEqual(rotated) {
+				rotation.Names = append(rotation.Names, nat.Name)
+			}
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// FamilyOf returns the family the mode of the store belongs to.
+// The mode is looked for in the families of all the modes of the store as Families does.
+func (ts TemplatesStore) FamilyOf(modeName Name) (Family, error) {
+	if !ts.Contains(modeName) {
+		return "", fmt.Errorf("got: '%s': %w", modeName, ErrNameUnknown)
+	}
+
+	for family, nats := range ts.Families() {
+		for _, nat := range nats {
+			if nat.Name == modeName {
+				return family, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("got: '%s': %w", modeName, ErrNameUnknown)
+}
+
+// Families groups the modes of the store into families: the modes which templates are rotations of each other.
+// Known families have their names, others are named after the mode going first in alphabetical order.
+// Modes of each family are sorted by degrees of the family's first mode and then by names,
+// so NaturalMajor goes together with Ionian, and NaturalMinor goes together with Aeolian.
+func (ts TemplatesStore) Families() map[Family]NamesAndTemplates {
+	families := make(map[Family]NamesAndTemplates)
+	parents := make(map[Family]Template)
+
+	for _, nat := range ts.AsSlice().SortByName(false) {
+		family, _, ok := nat.ModeTemplate.Family()
+		if !ok {
+			family = Family(nat.Name)
+			for unknownFamily, parent := range parents {
+				if _, ok := nat.ModeTemplate.RotationOf(parent); ok {
+					family = unknownFamily
+				}
+			}
+		}
+
+		if _, ok := parents[family]; !ok {
+			parents[family] = nat.ModeTemplate
+			if names, ok := getFamilies()[family]; ok {
+				parents[family], _ = GetTemplateByName(names[0])
+			}
+		}
+
+		families[family] = append(families[family], nat)
+	}
+
+	for family, nats := range families {
+		sort.SliceStable(nats, func(i, j int) bool {
+			degreeI, _ := nats[i].ModeTemplate.RotationOf(parents[family])
+			degreeJ, _ := nats[j].ModeTemplate.RotationOf(parents[family])
+
+			return degreeI < degreeJ
+		})
+	}
+
+	return families
+}
@@ -0,0 +1,141 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/degree"
+)
+
+func TestTemplate_Rotations(t *testing.T) {
+	rotations := TemplateIonian().Rotations()
+	require.Len(t, rotations, 7)
+	assert.Equal(t, []Template{
+		TemplateIonian(), TemplateDorian(), TemplatePhrygian(), TemplateLydian(), TemplateMixoLydian(), TemplateAeolian(), TemplateLocrian(),
+	}, rotations)
+
+	degreeNum, ok := TemplateLocrian().RotationOf(TemplateIonian())
+	assert.True(t, ok)
+	assert.Equal(t, degree.Number(7), degreeNum)
+
+	_, ok = TemplateHarmonicMinor().RotationOf(TemplateIonian())
+	assert.False(t, ok)
+
+	_, ok = TemplatePentatonicMajor().RotationOf(TemplateIonian())
+	assert.False(t, ok)
+
+	assert.True(t, TemplateIonian().IsEqual(TemplateNaturalMajor()))
+	assert.False(t, TemplateIonian().IsEqual(TemplateDorian()))
+}
+
+func TestTemplate_Family(t *testing.T) {
+	testCases := []struct {
+		template Template
+		family   Family
+		degree   degree.Number
+	}{
+		{template: TemplateNaturalMinor(), family: FamilyMajor, degree: 6},
+		{template: TemplateSuperLocrian(), family: FamilyMelodicMinor, degree: 7},
+		{template: TemplatePhrygianDominant(), family: FamilyHarmonicMinor, degree: 5},
+		{template: TemplateLydianDiminished(), family: FamilyHarmonicMajor, degree: 4},
+		{template: TemplateHungarianMinor(), family: FamilyDoubleHarmonicMajor, degree: 4},
+		{template: TemplatePentatonicMinor(), family: FamilyPentatonic, degree: 5},
+		{template: TemplatePentatonicIwato(), family: FamilyJapanesePentatonic, degree: 2},
+	}
+
+	for _, testCase := range testCases {
+		family, degreeNum, ok := testCase.template.Family()
+		assert.True(t, ok, testCase.family)
+		assert.Equal(t, testCase.family, family)
+		assert.Equal(t, testCase.degree, degreeNum, testCase.family)
+	}
+
+	_, _, ok := Template{2, 2, 2, 2, 2, 2}.Family()
+	assert.False(t, ok)
+}
+
+func TestGetFamilyByName(t *testing.T) {
+	for family, names := range getFamilies() {
+		parent, err := GetTemplateByName(names[0])
+		require.NoError(t, err)
+
+		for i, name := range names {
+			template, err := GetTemplateByName(name)
+			require.NoError(t, err)
+			assert.Equal(t, parent.RearrangeFromDegree(degree.Number(i+1)), template, "family: %s, mode: %s", family, name) //nolint:gosec
+		}
+	}
+
+	names, err := GetFamilyByName(FamilyMelodicMinor)
+	require.NoError(t, err)
+	assert.Len(t, names, 7)
+
+	_, err = GetFamilyByName("Unknown")
+	require.ErrorIs(t, err, ErrFamilyUnknown)
+}
+
+func TestTemplatesStore_Rotations(t *testing.T) {
+	rotations := InitTemplatesStore().Rotations(TemplateHarmonicMinor())
+	require.Len(t, rotations, 7)
+	assert.Equal(t, degree.Number(1), rotations[0].Degree)
+	assert.Equal(t, []Name{NameAeolianRais7, NameHarmonicMinor}, rotations[0].Names)
+	assert.Equal(t, []Name{NamePhrygianDominant}, rotations[4].Names)
+	assert.Equal(t, TemplatePhrygianDominant(), rotations[4].Template)
+
+	ts := TemplatesStore{NameIonian: TemplateIonian()}
+	rotations = ts.Rotations(TemplateDorian())
+	assert.Empty(t, rotations[0].Names)
+	assert.Equal(t, []Name{NameIonian}, rotations[6].Names)
+}
+
+func TestTemplatesStore_Families(t *testing.T) {
+	mts := InitTemplatesStore()
+	families := mts.Families()
+
+	major := families[FamilyMajor]
+	require.Len(t, major, 9)
+	assert.Equal(t, NameIonian, major[0].Name)
+	assert.Equal(t, NameNaturalMajor, major[1].Name)
+	assert.Equal(t, NameLocrian, major[8].Name)
+
+	assert.Len(t, families[FamilyMelodicMinor], 9)
+	assert.Len(t, families[FamilyHarmonicMinor], 8)
+	assert.Len(t, families[FamilyHarmonicMajor], 8)
+	assert.Len(t, families[FamilyDoubleHarmonicMajor], 7)
+	assert.Len(t, families[FamilyPentatonic], 5)
+
+	family, err := mts.FamilyOf(NameMelodicMajor)
+	require.NoError(t, err)
+	assert.Equal(t, FamilyMelodicMinor, family)
+
+	_, err = mts.FamilyOf("Unknown")
+	require.ErrorIs(t, err, ErrNameUnknown)
+
+	t.Run("unknown families", func(t *testing.T) {
+		ts := TemplatesStore{
+			"WholeTone":  {2, 2, 2, 2, 2, 2},
+			"B":          {1, 2, 1, 2, 1, 2, 1, 2},
+			"A":          {2, 1, 2, 1, 2, 1, 2, 1},
+			NameDorian:   TemplateDorian(),
+			NameLydian:   TemplateLydian(),
+			NameAeolian:  TemplateAeolian(),
+			NameLocrian:  TemplateLocrian(),
+			NamePhrygian: TemplatePhrygian(),
+		}
+
+		families := ts.Families()
+		assert.Len(t, families, 3)
+		assert.Len(t, families["WholeTone"], 1)
+		assert.Equal(t, NamesAndTemplates{{"A", ts["A"]}, {"B", ts["B"]}}, families["A"])
+		assert.Equal(t, NamesAndTemplates{
+			{NameDorian, TemplateDorian()}, {NamePhrygian, TemplatePhrygian()}, {NameLydian, TemplateLydian()},
+			{NameAeolian, TemplateAeolian()}, {NameLocrian, TemplateLocrian()},
+		}, families[FamilyMajor])
+
+		family, err := ts.FamilyOf("B")
+		require.NoError(t, err)
+		assert.Equal(t, Family("A"), family)
+	})
+}
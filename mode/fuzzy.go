@@ -0,0 +1,194 @@
+package mode
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+// Weighting defines how much each of the given notes counts in the fuzzy search of modes.
+type Weighting uint8
+
+const (
+	// WeightingByOccurrence counts each occurrence of a note once, so frequent notes count more.
+	WeightingByOccurrence Weighting = iota
+	// WeightingByDuration counts each note by its duration: the absolute one if it's set, otherwise the relative one.
+	// Notes without durations count as occurrences.
+	WeightingByDuration
+	// WeightingEqual counts each pitch class once regardless of its occurrences and durations.
+	WeightingEqual
+)
+
+// FuzzyOptions are the options of the fuzzy search of modes.
+type FuzzyOptions struct {
+	MaxMissing int       // Maximum amount of degrees of the mode absent among the given notes
+	MaxExtra   int       // Maximum amount of pitch classes of the given notes out of the mode
+	Weighting  Weighting // How the given notes count in the score
+}
+
+// FuzzyMatch is a mode found by the fuzzy search with its score and mismatching notes.
+type FuzzyMatch struct {
+	TemplateWithPrime
+	Score   float64    // From 0 to 1, where 1 means that the notes are exactly the notes of the mode
+	Missing note.Notes // Notes of the mode absent among the given notes
+	Extra   note.Notes // Given notes out of the mode
+}
+
+// FuzzyMatches is a list of modes found by the fuzzy search.
+type FuzzyMatches []FuzzyMatch
+
+// Contains checks if the mode with the given name is in the list.
+func (fms FuzzyMatches) Contains(modeName Name) bool {
+	for _, fm := range fms {
+		if fm.Name == modeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// noteWeight returns how much the note counts in the fuzzy search.
+func noteWeight(n *note.Note, weighting Weighting) float64 {
+	if weighting != WeightingByDuration {
+		return 1
+	}
+
+	if n.Duration() > 0 {
+		return n.Duration().Seconds()
+	}
+
+	// A bar per minute just gives a value proportional to the relative duration
+	if d := n.GetTimeDuration(decimal.NewFromInt(1)); d > 0 {
+		return d.Seconds()
+	}
+
+	return 1
+}
+
+// FindModeTemplatesByNotesFuzzy searches for modes in the storage that match the given notes
+// with the given amount of missing degrees and extra notes, so melodies with chromatic passing tones still find their modes.
+// Notes are compared by pitch classes, so enharmonically equal notes are the same.
+// The score of a mode is the weighted share of the notes within the mode multiplied by the share of the degrees of the mode present among the notes.
+// Modes are built from each of the twelve pitch classes. A tonic present among the notes keeps its spelling,
+// other tonics are taken from note.GetSetFullChromatic, preferring flats.
+// The result is sorted from the best match to the worst one, then by mode names and pitch classes of tonics.
+func (ts TemplatesStore) FindModeTemplatesByNotesFuzzy(ns note.Notes, opts FuzzyOptions) FuzzyMatches {
+	result := make(FuzzyMatches, 0)
+
+	weights := make(map[uint8]float64)
+	spellings := make(map[uint8]*note.Note)
+	var totalWeight float64
+	for _, n := range ns {
+		if n == nil || n.IsMicrotonal() {
+			continue
+		}
+
+		pc := pitchClassOf(n)
+		weight := noteWeight(n, opts.Weighting)
+		if opts.Weighting == WeightingEqual {
+			weight = 0
+			if _, ok := weights[pc]; !ok {
+				weight = 1
+			}
+		}
+		weights[pc] += weight
+		totalWeight += weight
+
+		if _, ok := spellings[pc]; !ok {
+			spellings[pc] = n
+		}
+	}
+
+	if totalWeight == 0 {
+		return result
+	}
+
+	for _, tonic := range note.GetSetFullChromatic() {
+		if _, ok := spellings[pitchClassOf(tonic)]; !ok {
+			spellings[pitchClassOf(tonic)] = tonic
+		}
+	}
+
+	for _, nat := range ts.AsSlice() {
+		if nat.ModeTemplate.Validate() != nil {
+			continue
+		}
+
+		for tonic := range uint8(halftone.HalfTonesInOctave) {
+			if match, ok := matchFuzzy(nat, spellings[tonic], ns, weights, totalWeight, opts); ok {
+				result = append(result, match)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+
+		return pitchClassOf(result[i].PrimeNote) < pitchClassOf(result[j].PrimeNote)
+	})
+
+	return result
+}
+
+// matchFuzzy compares the notes with the mode built from the tonic.
+func matchFuzzy(nat NameAndTemplate, tonic *note.Note, ns note.Notes, weights map[uint8]float64, totalWeight float64, opts FuzzyOptions) (FuzzyMatch, bool) {
+	mask := maskOfTemplate(nat.ModeTemplate, pitchClassOf(tonic))
+
+	var extraPitchClasses int
+	var weightInMode float64
+	for pc, weight := range weights {
+		if mask&(1<<pc) == 0 {
+			extraPitchClasses++
+		} else {
+			weightInMode += weight
+		}
+	}
+
+	var missingDegrees int
+	for pc := range uint8(halftone.HalfTonesInOctave) {
+		if _, ok := weights[pc]; !ok && mask&(1<<pc) != 0 {
+			missingDegrees++
+		}
+	}
+
+	if extraPitchClasses > opts.MaxExtra || missingDegrees > opts.MaxMissing {
+		return FuzzyMatch{}, false
+	}
+
+	match := FuzzyMatch{
+		TemplateWithPrime: TemplateWithPrime{
+			NameAndTemplate: &NameAndTemplate{Name: nat.Name, ModeTemplate: nat.ModeTemplate},
+			PrimeNote:       tonic.Copy(),
+		},
+		Score:   weightInMode / totalWeight * float64(int(nat.ModeTemplate.Length())-missingDegrees) / float64(nat.ModeTemplate.Length()),
+		Missing: make(note.Notes, 0, missingDegrees),
+		Extra:   make(note.Notes, 0),
+	}
+
+	if missingDegrees > 0 {
+		m := newModeBuilder(nat.ModeTemplate).build(nat.Name, tonic.Copy())
+		for d := range m.IterateOneRound(false) {
+			if _, ok := weights[pitchClassOf(d.Note())]; !ok {
+				match.Missing = append(match.Missing, d.Note().Copy())
+			}
+		}
+	}
+
+	for _, n := range ns.Uniques() {
+		if n != nil && !n.IsMicrotonal() && mask&(1<<pitchClassOf(n)) == 0 {
+			match.Extra = append(match.Extra, n.Copy())
+		}
+	}
+
+	return match, true
+}
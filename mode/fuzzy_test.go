@@ -0,0 +1,122 @@
+package mode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+)
+
+func TestTemplatesStore_FindModeTemplatesByNotesFuzzy(t *testing.T) {
+	mts := InitTemplatesStore()
+
+	t.Run("exact match without tolerance", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.F, note.G, note.A, note.B)
+		result := mts.FindModeTemplatesByNotesFuzzy(notes, FuzzyOptions{})
+
+		expectedModes := []Name{NameAeolian, NameIonian, NamePhrygian, NameLocrian, NameDorian, NameLydian, NameMixoLydian, NameNaturalMajor, NameNaturalMinor}
+		require.Len(t, result, len(expectedModes))
+		for _, modeName := range expectedModes {
+			assert.True(t, result.Contains(modeName), "expected mode name: %s", modeName)
+		}
+
+		for _, match := range result {
+			assert.InDelta(t, 1.0, match.Score, 0)
+			assert.Empty(t, match.Missing)
+			assert.Empty(t, match.Extra)
+		}
+	})
+
+	t.Run("chromatic passing tone", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.F, note.FSHARP, note.G, note.A, note.B, note.C, note.G, note.F)
+		heptatonic := mts.FindModeTemplatesByDegrees(DegreesInHeptatonic)
+		assert.Empty(t, heptatonic.FindModeTemplatesByNotesFuzzy(notes, FuzzyOptions{}))
+
+		result := heptatonic.FindModeTemplatesByNotesFuzzy(notes, FuzzyOptions{MaxExtra: 1})
+		require.NotEmpty(t, result)
+		assert.Equal(t, note.MustNewNotesFromNoteNames(note.FSHARP), result[0].Extra)
+		assert.Empty(t, result[0].Missing)
+		assert.InDelta(t, 10.0/11, result[0].Score, 0.0001)
+
+		for i := 1; i < len(result); i++ {
+			assert.GreaterOrEqual(t, result[i-1].Score, result[i].Score)
+		}
+
+		// The passing tone is a degree of the bebop scales
+		result = mts.FindModeTemplatesByNotesFuzzy(notes, FuzzyOptions{})
+		require.Len(t, result, 2)
+		assert.True(t, result.Contains(NameBebopDominant))
+		assert.True(t, result.Contains(NameBebopDorian))
+		for _, match := range result {
+			assert.InDelta(t, 1.0, match.Score, 0)
+		}
+	})
+
+	t.Run("missing degrees", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.A, note.C, note.E, note.G)
+		result := mts.FindModeTemplatesByNotesFuzzy(notes, FuzzyOptions{MaxMissing: 1})
+
+		// Only pentatonic modes miss one degree
+		expectedTonics := map[Name]note.Name{
+			NamePentatonicBluesMajor: note.G,
+			NamePentatonicBluesMinor: note.E,
+			NamePentatonicMajor:      note.C,
+			NamePentatonicMinor:      note.A,
+			NamePentatonicSustained:  note.D,
+		}
+		require.Len(t, result, len(expectedTonics))
+		for _, match := range result {
+			assert.Equal(t, expectedTonics[match.Name], match.PrimeNote.Name(), match.Name)
+			assert.Equal(t, note.MustNewNotesFromNoteNames(note.D), match.Missing, match.Name)
+			assert.InDelta(t, 0.8, match.Score, 0.0001, match.Name)
+		}
+	})
+
+	t.Run("weighting by duration", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.C, note.E, note.G, note.CSHARP)
+		notes[0].SetDuration(time.Second)
+		notes[1].SetDuration(time.Second)
+		notes[2].SetDuration(time.Second)
+		notes[3].SetDuration(time.Second / 3)
+
+		opts := FuzzyOptions{MaxMissing: 4, MaxExtra: 1, Weighting: WeightingByDuration}
+		for _, match := range mts.FindModeTemplatesByNotesFuzzy(notes, opts) {
+			if match.Name == NameIonian && match.PrimeNote.Name() == note.C {
+				assert.InDelta(t, 0.9*3.0/7, match.Score, 0.0001)
+				require.Len(t, match.Extra, 1)
+				assert.Equal(t, note.CSHARP, match.Extra[0].Name())
+				assert.Equal(t, note.MustNewNotesFromNoteNames(note.D, note.F, note.A, note.B), match.Missing)
+
+				return
+			}
+		}
+
+		assert.Fail(t, "C Ionian is not found")
+	})
+
+	t.Run("weighting by occurrence and equal weighting", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.C, note.C, note.C, note.D, note.E, note.F, note.G, note.A, note.BFLAT)
+		opts := FuzzyOptions{MaxExtra: 1, MaxMissing: 1}
+
+		for _, match := range mts.FindModeTemplatesByNotesFuzzy(notes, opts) {
+			if match.Name == NameIonian && match.PrimeNote.Name() == note.C {
+				assert.InDelta(t, 8.0/9*6/7, match.Score, 0.0001)
+			}
+		}
+
+		opts.Weighting = WeightingEqual
+		for _, match := range mts.FindModeTemplatesByNotesFuzzy(notes, opts) {
+			if match.Name == NameIonian && match.PrimeNote.Name() == note.C {
+				assert.InDelta(t, 6.0/7*6/7, match.Score, 0.0001)
+			}
+		}
+	})
+
+	t.Run("no notes", func(t *testing.T) {
+		assert.Empty(t, mts.FindModeTemplatesByNotesFuzzy(nil, FuzzyOptions{MaxMissing: 7}))
+		assert.Empty(t, mts.FindModeTemplatesByNotesFuzzy(note.Notes{nil}, FuzzyOptions{MaxMissing: 7}))
+	})
+}
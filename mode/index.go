@@ -0,0 +1,153 @@
+package mode
+
+import (
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+// pitchClassSets is amount of all the possible sets of pitch classes of the twelve-tone equal temperament.
+const pitchClassSets = 1 << halftone.HalfTonesInOctave
+
+// pitchClassMask is a set of pitch classes as 12-bit mask: the bit n is set if the pitch class n is in the set.
+type pitchClassMask uint16
+
+// pitchClassOf returns the pitch class of the note: 0 for C, 1 for C# and Db, ..., 11 for B.
+func pitchClassOf(n *note.Note) uint8 {
+	octave := int(halftone.HalfTonesInOctave)
+
+	return uint8(((n.ChromaticPosition() % octave) + octave) % octave) //nolint:gosec // the value is within octave
+}
+
+// maskOfTemplate returns pitch classes of the mode template built from the given pitch class.
+func maskOfTemplate(template Template, tonic uint8) pitchClassMask {
+	mask := pitchClassMask(1) << tonic
+	position := int(tonic)
+	for _, halfTones := range template[:len(template)-1] {
+		position = (position + int(halfTones)) % int(halftone.HalfTonesInOctave)
+		mask |= 1 << position
+	}
+
+	return mask
+}
+
+// indexedTemplate is a mode template of the index with the names of the notes of its modes built from the usual tonics.
+type indexedTemplate struct {
+	NameAndTemplate
+	spellings map[note.Name]map[note.Name]struct{}
+}
+
+// indexedTransposition is a mode template of the index built from the pitch class.
+type indexedTransposition struct {
+	template uint16
+	tonic    uint8
+}
+
+// PitchClassIndex is the precomputed index of the mode templates of the store by the pitch classes of all their transpositions.
+// It answers which modes contain the given notes without building the modes.
+// Templates added to the store after creating the index are not included in it.
+type PitchClassIndex struct {
+	templates []indexedTemplate
+	supersets [pitchClassSets][]indexedTransposition
+}
+
+// NewPitchClassIndex creates the index of the twelve-tone mode templates of the store.
+// Invalid templates are skipped.
+func (ts TemplatesStore) NewPitchClassIndex() *PitchClassIndex {
+	index := &PitchClassIndex{}
+
+	for _, nat := range ts.AsSlice().SortByName(false) {
+		if nat.ModeTemplate.Validate() != nil {
+			continue
+		}
+
+		it := indexedTemplate{NameAndTemplate: nat, spellings: make(map[note.Name]map[note.Name]struct{})}
+		for _, tonic := range note.GetSetFullChromatic() {
+			it.spellings[tonic.Name()] = spellMode(nat, tonic)
+		}
+		index.templates = append(index.templates, it)
+		templateIndex := uint16(len(index.templates) - 1) //nolint:gosec // amount of templates is small
+
+		for tonic := range uint8(halftone.HalfTonesInOctave) {
+			// Each subset of the pitch classes of the mode refers to it
+			mask := maskOfTemplate(nat.ModeTemplate, tonic)
+			for subset := mask; ; subset = (subset - 1) & mask {
+				index.supersets[subset] = append(index.supersets[subset], indexedTransposition{templateIndex, tonic})
+				if subset == 0 {
+					break
+				}
+			}
+		}
+	}
+
+	return index
+}
+
+// spellMode returns names of the notes of the mode built from the tonic.
+func spellMode(nat NameAndTemplate, tonic *note.Note) map[note.Name]struct{} {
+	spelling := make(map[note.Name]struct{}, nat.ModeTemplate.Length())
+	m := newModeBuilder(nat.ModeTemplate).build(nat.Name, tonic.Copy())
+	for d := range m.IterateOneRound(false) {
+		spelling[d.Note().Name()] = struct{}{}
+	}
+
+	return spelling
+}
+
+// FindModeTemplatesByNotes searches for modes in the index that contain the given set of notes,
+// with one of the notes as the tonic. The result is the same as TemplatesStore.FindModeTemplatesByNotes gives,
+// so the notes must be spelled as in the found modes, but the result is sorted by mode names and pitch classes of tonics.
+func (idx *PitchClassIndex) FindModeTemplatesByNotes(ns note.Notes) TemplatesWithPrime {
+	result := make(TemplatesWithPrime, 0)
+	if idx == nil {
+		return result
+	}
+
+	var mask pitchClassMask
+	for _, n := range ns {
+		if n == nil || n.IsMicrotonal() {
+			return result
+		}
+		mask |= 1 << pitchClassOf(n)
+	}
+
+	tonics := ns.Uniques()
+	tonicPitchClasses := make([]uint8, len(tonics))
+	for i, tonic := range tonics {
+		tonicPitchClasses[i] = pitchClassOf(tonic)
+	}
+
+	for _, transposition := range idx.supersets[mask] {
+		it := &idx.templates[transposition.template]
+		for i, tonic := range tonics {
+			if tonicPitchClasses[i] != transposition.tonic || !it.isSpelledWith(tonic, ns) {
+				continue
+			}
+
+			result = append(result, TemplateWithPrime{
+				NameAndTemplate: &NameAndTemplate{
+					Name:         it.Name,
+					ModeTemplate: it.ModeTemplate,
+				},
+				PrimeNote: tonic.Copy(),
+			})
+		}
+	}
+
+	return deduplicateByPitchClasses(result, tonics)
+}
+
+// isSpelledWith checks if the mode built from the tonic contains the notes with the same names.
+func (it *indexedTemplate) isSpelledWith(tonic *note.Note, ns note.Notes) bool {
+	spelling, ok := it.spellings[tonic.Name()]
+	if !ok {
+		spelling = spellMode(it.NameAndTemplate, tonic)
+	}
+
+	for _, n := range ns {
+		if _, ok := spelling[n.Name()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+)
+
+// sortedResult returns the result sorted by mode names and prime notes to compare it regardless of the order.
+func sortedResult(result TemplatesWithPrime) TemplatesWithPrime {
+	return result.SortByPrimeNote(false)
+}
+
+func TestPitchClassIndex_FindModeTemplatesByNotes(t *testing.T) {
+	mts := InitTemplatesStore()
+	index := mts.NewPitchClassIndex()
+
+	t.Run("same result as the store gives", func(t *testing.T) {
+		noteSets := []note.Notes{
+			note.MustNewNotesFromNoteNames(note.C, note.E, note.G),
+			note.MustNewNotesFromNoteNames(note.D, note.F, note.AFLAT, note.B),
+			note.MustNewNotesFromNoteNames(note.EFLAT, note.G, note.BFLAT, note.D, note.F),
+			note.MustNewNotesFromNoteNames(note.A, note.B, note.C, note.D, note.E, note.F, note.GSHARP),
+		}
+
+		chromatic := note.GetSetFullChromatic()
+		for i := range chromatic {
+			for j := i + 1; j < len(chromatic); j++ {
+				noteSets = append(noteSets, note.Notes{chromatic[i], chromatic[j]})
+			}
+		}
+
+		for _, notes := range noteSets {
+			expected := sortedResult(mts.FindModeTemplatesByNotes(notes))
+			actual := sortedResult(index.FindModeTemplatesByNotes(notes))
+			require.Equal(t, expected, actual, "notes: %s", notes)
+		}
+	})
+
+	t.Run("diatonic notes", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.F, note.G, note.A, note.B, note.C)
+		result := index.FindModeTemplatesByNotes(notes)
+
+		expectedModes := []Name{
+			NameAeolian, NameIonian, NamePhrygian, NameLocrian, NameDorian, NameLydian, NameMixoLydian, NameNaturalMajor, NameNaturalMinor,
+			// Bebop modes from C and G contain the passing tones out of the notes
+			NameBebopDominant, NameBebopDominant, NameBebopDorian, NameBebopDorian, NameBebopMajor,
+		}
+		assert.Len(t, result, len(expectedModes))
+		for _, modeName := range expectedModes {
+			assert.True(t, result.Contains(modeName), "expected mode name: %s", modeName)
+		}
+	})
+
+	t.Run("spelling matters", func(t *testing.T) {
+		result := index.FindModeTemplatesByNotes(note.MustNewNotesFromNoteNames(note.C, note.E, note.GFLAT, note.G))
+		assert.False(t, result.Contains(NameLydian))
+
+		result = index.FindModeTemplatesByNotes(note.MustNewNotesFromNoteNames(note.C, note.E, note.FSHARP, note.G))
+		assert.True(t, result.Contains(NameLydian))
+	})
+
+	t.Run("tonics out of the precomputed ones", func(t *testing.T) {
+		notes := note.MustNewNotesFromNoteNames(note.ESHARP, note.GSHARP2, note.BSHARP)
+		assert.Equal(t, sortedResult(mts.FindModeTemplatesByNotes(notes)), sortedResult(index.FindModeTemplatesByNotes(notes)))
+		assert.NotEmpty(t, index.FindModeTemplatesByNotes(notes))
+	})
+
+	t.Run("no result", func(t *testing.T) {
+		assert.Empty(t, index.FindModeTemplatesByNotes(nil))
+		assert.Empty(t, index.FindModeTemplatesByNotes(note.Notes{nil}))
+		assert.Empty(t, index.FindModeTemplatesByNotes(note.Notes{note.MustNewNote(note.C).AlterUpByQuarterTone()}))
+		assert.Empty(t, index.FindModeTemplatesByNotes(note.MustNewNotesFromNoteNames(note.C, note.DFLAT, note.D, note.EFLAT, note.E, note.F)))
+
+		var nilIndex *PitchClassIndex
+		assert.Empty(t, nilIndex.FindModeTemplatesByNotes(note.MustNewNotesFromNoteNames(note.C)))
+	})
+}
+
+func benchmarkNotes() note.Notes {
+	return note.MustNewNotesFromNoteNames(note.E, note.FSHARP, note.G, note.B)
+}
+
+func BenchmarkTemplatesStore_FindModeTemplatesByNotes(b *testing.B) {
+	mts := InitTemplatesStore()
+	notes := benchmarkNotes()
+
+	b.ReportAllocs()
+	for range b.N {
+		mts.FindModeTemplatesByNotes(notes)
+	}
+}
+
+func BenchmarkPitchClassIndex_FindModeTemplatesByNotes(b *testing.B) {
+	index := InitTemplatesStore().NewPitchClassIndex()
+	notes := benchmarkNotes()
+
+	b.ReportAllocs()
+	for range b.N {
+		index.FindModeTemplatesByNotes(notes)
+	}
+}
+
+func BenchmarkTemplatesStore_NewPitchClassIndex(b *testing.B) {
+	mts := InitTemplatesStore()
+
+	b.ReportAllocs()
+	for range b.N {
+		mts.NewPitchClassIndex()
+	}
+}
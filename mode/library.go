@@ -0,0 +1,245 @@
+package mode
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-muse/muse/halftone"
+)
+
+// TemplateRecord is a mode template of the library with its names and metadata.
+// Steps are kept as plain numbers, so the record is readable in JSON and YAML files.
+type TemplateRecord struct {
+	Name    Name     `json:"name"              yaml:"name"`
+	Aliases []Name   `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Family  Family   `json:"family,omitempty"  yaml:"family,omitempty"`
+	Steps   []int    `json:"steps"             yaml:"steps,flow"`
+	Origin  string   `json:"origin,omitempty"  yaml:"origin,omitempty"`
+	Tags    []string `json:"tags,omitempty"    yaml:"tags,omitempty,flow"`
+}
+
+// Template returns steps of the record as mode template.
+func (tr TemplateRecord) Template() Template {
+	t := make(Template, 0, len(tr.Steps))
+	for _, step := range tr.Steps {
+		t = append(t, halftone.HalfTones(step)) //nolint:gosec // steps are checked by validation
+	}
+
+	return t
+}
+
+// Names returns the name of the record followed by its aliases.
+func (tr TemplateRecord) Names() []Name {
+	return append([]Name{tr.Name}, tr.Aliases...)
+}
+
+// HasTag checks if the record is marked with the tag.
+func (tr TemplateRecord) HasTag(tag string) bool {
+	return slices.Contains(tr.Tags, tag)
+}
+
+// Library is a collection of mode templates with metadata that can be saved to and loaded from JSON and YAML files,
+// so custom templates can be shared and added to the store.
+type Library struct {
+	Templates []TemplateRecord `json:"templates" yaml:"templates"`
+}
+
+var (
+	// ErrLibraryInvalid is returned when the library of mode templates can't be parsed or is inconsistent.
+	ErrLibraryInvalid = errors.New("invalid mode templates library")
+	// ErrTemplateConflict is returned when stores to merge have different templates with the same name.
+	ErrTemplateConflict = errors.New("conflicting mode templates")
+)
+
+// LoadLibraryJSON reads the library of mode templates in JSON format and validates it.
+func LoadLibraryJSON(r io.Reader) (*Library, error) {
+	library := &Library{}
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(library); err != nil {
+		return nil, fmt.Errorf("decode json: %w: %w", ErrLibraryInvalid, err)
+	}
+
+	if err := library.Validate(); err != nil {
+		return nil, err
+	}
+
+	return library, nil
+}
+
+// LoadLibraryYAML reads the library of mode templates in YAML format and validates it.
+func LoadLibraryYAML(r io.Reader) (*Library, error) {
+	library := &Library{}
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(library); err != nil {
+		return nil, fmt.Errorf("decode yaml: %w: %w", ErrLibraryInvalid, err)
+	}
+
+	if err := library.Validate(); err != nil {
+		return nil, err
+	}
+
+	return library, nil
+}
+
+// WriteJSON writes the library in JSON format with indentation.
+func (l *Library) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(l); err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+
+	return nil
+}
+
+// WriteYAML writes the library in YAML format.
+func (l *Library) WriteYAML(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2) //nolint:mnd
+	if err := encoder.Encode(l); err != nil {
+		return fmt.Errorf("encode yaml: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("encode yaml: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks that each template of the library is valid and has a name,
+// and that names and aliases are not repeated.
+func (l *Library) Validate() error {
+	if l == nil {
+		return fmt.Errorf("library is nil: %w", ErrLibraryInvalid)
+	}
+
+	names := make(map[Name]struct{})
+	for i, record := range l.Templates {
+		if record.Name == "" {
+			return fmt.Errorf("template %d has no name: %w", i+1, ErrLibraryInvalid)
+		}
+
+		for _, step := range record.Steps {
+			if step < 0 || step > int(halftone.HalfTonesInOctave) {
+				return fmt.Errorf("template '%s' has step %d: %w: %w", record.Name, step, ErrLibraryInvalid, ErrInvalidModeTemplate)
+			}
+		}
+
+		if err := record.Template().Validate(); err != nil {
+			return fmt.Errorf("template '%s': %w: %w", record.Name, ErrLibraryInvalid, err)
+		}
+
+		for _, name := range record.Names() {
+			if _, ok := names[name]; ok {
+				return fmt.Errorf("name '%s' is repeated: %w", name, ErrLibraryInvalid)
+			}
+			names[name] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// Find returns the record of the library with the given name or alias.
+func (l *Library) Find(modeName Name) (TemplateRecord, bool) {
+	if l == nil {
+		return TemplateRecord{}, false
+	}
+
+	for _, record := range l.Templates {
+		if slices.Contains(record.Names(), modeName) {
+			return record, true
+		}
+	}
+
+	return TemplateRecord{}, false
+}
+
+// FilterByTag returns the records of the library marked with the tag.
+func (l *Library) FilterByTag(tag string) []TemplateRecord {
+	records := make([]TemplateRecord, 0)
+	if l == nil {
+		return records
+	}
+
+	for _, record := range l.Templates {
+		if record.HasTag(tag) {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// Store creates the store of mode templates from the library. Aliases are added as names of the same templates.
+func (l *Library) Store() TemplatesStore {
+	ts := make(TemplatesStore)
+	if l == nil {
+		return ts
+	}
+
+	for _, record := range l.Templates {
+		for _, name := range record.Names() {
+			ts.AddTemplate(name, record.Template())
+		}
+	}
+
+	return ts
+}
+
+// Library creates the library from the store sorted by names. Families of the modes are defined as Families does.
+func (ts TemplatesStore) Library() *Library {
+	families := make(map[Name]Family)
+	for family, nats := range ts.Families() {
+		for _, nat := range nats {
+			families[nat.Name] = family
+		}
+	}
+
+	library := &Library{Templates: make([]TemplateRecord, 0, len(ts))}
+	for _, nat := range ts.AsSlice().SortByName(false) {
+		steps := make([]int, 0, nat.ModeTemplate.Length())
+		for _, step := range nat.ModeTemplate {
+			steps = append(steps, int(step))
+		}
+
+		library.Templates = append(library.Templates, TemplateRecord{Name: nat.Name, Family: families[nat.Name], Steps: steps})
+	}
+
+	return library
+}
+
+// Merge adds the templates of the other store to the store, e.g. a library of custom templates to the built-in store.
+// Templates with the same name must be equal unless overwrite is true, then the templates of the other store replace them.
+// Nothing is added in case of conflict.
+func (ts TemplatesStore) Merge(other TemplatesStore, overwrite bool) error {
+	if !overwrite {
+		conflicts := make([]Name, 0)
+		for modeName, modeTemplate := range other {
+			if stored, ok := ts[modeName]; ok && !stored.IsEqual(modeTemplate) {
+				conflicts = append(conflicts, modeName)
+			}
+		}
+
+		if len(conflicts) > 0 {
+			sort.Slice(conflicts, func(i, j int) bool { return conflicts[i] < conflicts[j] })
+
+			return fmt.Errorf("names %v: %w", conflicts, ErrTemplateConflict)
+		}
+	}
+
+	for modeName, modeTemplate := range other {
+		ts.AddTemplate(modeName, modeTemplate)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package mode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLibraryYAML = `templates:
+  - name: Prometheus
+    aliases: [MysticChord]
+    steps: [2, 2, 2, 3, 1, 2]
+    origin: Scriabin
+    tags: [hexatonic, russian]
+  - name: Pelog
+    family: Gamelan
+    steps: [1, 2, 4, 1, 4]
+    origin: Java
+    tags: [pentatonic]
+`
+
+func TestLoadLibraryYAML(t *testing.T) {
+	library, err := LoadLibraryYAML(strings.NewReader(testLibraryYAML))
+	require.NoError(t, err)
+	require.Len(t, library.Templates, 2)
+
+	record, ok := library.Find("MysticChord")
+	require.True(t, ok)
+	assert.Equal(t, Name("Prometheus"), record.Name)
+	assert.Equal(t, Template{2, 2, 2, 3, 1, 2}, record.Template())
+	assert.Equal(t, "Scriabin", record.Origin)
+
+	_, ok = library.Find("Unknown")
+	assert.False(t, ok)
+
+	pentatonics := library.FilterByTag("pentatonic")
+	require.Len(t, pentatonics, 1)
+	assert.Equal(t, Family("Gamelan"), pentatonics[0].Family)
+	assert.Empty(t, library.FilterByTag("unknown"))
+
+	store := library.Store()
+	assert.Len(t, store, 3)
+	assert.Equal(t, store["Prometheus"], store["MysticChord"])
+}
+
+func TestLibrary_RoundTrip(t *testing.T) {
+	mts := InitTemplatesStore()
+	library := mts.Library()
+	require.Len(t, library.Templates, len(mts))
+
+	record, ok := library.Find(NameDorian)
+	require.True(t, ok)
+	assert.Equal(t, FamilyMajor, record.Family)
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, library.WriteJSON(&buf))
+
+		loaded, err := LoadLibraryJSON(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, library, loaded)
+		assert.Equal(t, mts, loaded.Store())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, library.WriteYAML(&buf))
+
+		loaded, err := LoadLibraryYAML(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, library, loaded)
+		assert.Equal(t, mts, loaded.Store())
+	})
+}
+
+func TestLibrary_Validate(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+	}{
+		{"invalid json", `{"templates": [`},
+		{"unknown field", `{"templates": [{"name": "A", "steps": [12], "colour": "blue"}]}`},
+		{"no name", `{"templates": [{"steps": [12]}]}`},
+		{"steps overflow octave", `{"templates": [{"name": "A", "steps": [2, 2, 2]}]}`},
+		{"zero step", `{"templates": [{"name": "A", "steps": [0, 12]}]}`},
+		{"negative step", `{"templates": [{"name": "A", "steps": [-1, 13]}]}`},
+		{"step out of octave", `{"templates": [{"name": "A", "steps": [268, -256]}]}`},
+		{"repeated name", `{"templates": [{"name": "A", "steps": [12]}, {"name": "A", "steps": [6, 6]}]}`},
+		{"alias repeats name", `{"templates": [{"name": "A", "steps": [12]}, {"name": "B", "aliases": ["A"], "steps": [6, 6]}]}`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := LoadLibraryJSON(strings.NewReader(testCase.json))
+			require.ErrorIs(t, err, ErrLibraryInvalid)
+		})
+	}
+
+	_, err := LoadLibraryYAML(strings.NewReader("templates:\n  - name: A\n    steps: [5, 5]\n"))
+	require.ErrorIs(t, err, ErrLibraryInvalid)
+	require.ErrorIs(t, err, ErrInvalidModeTemplate)
+
+	var nilLibrary *Library
+	require.ErrorIs(t, nilLibrary.Validate(), ErrLibraryInvalid)
+	assert.Empty(t, nilLibrary.Store())
+	assert.Empty(t, nilLibrary.FilterByTag("any"))
+}
+
+func TestTemplatesStore_Merge(t *testing.T) {
+	library, err := LoadLibraryYAML(strings.NewReader(testLibraryYAML))
+	require.NoError(t, err)
+
+	t.Run("new templates", func(t *testing.T) {
+		mts := InitTemplatesStore()
+		size := len(mts)
+		require.NoError(t, mts.Merge(library.Store(), false))
+		assert.Len(t, mts, size+3)
+		assert.True(t, mts.Contains("Pelog"))
+	})
+
+	t.Run("equal templates", func(t *testing.T) {
+		mts := InitTemplatesStore()
+		require.NoError(t, mts.Merge(TemplatesStore{NameIonian: TemplateIonian()}, false))
+		assert.Equal(t, InitTemplatesStore(), mts)
+	})
+
+	t.Run("conflicting templates", func(t *testing.T) {
+		mts := InitTemplatesStore()
+		other := TemplatesStore{NameIonian: TemplateDorian(), "Pelog": Template{1, 2, 4, 1, 4}}
+		require.ErrorIs(t, mts.Merge(other, false), ErrTemplateConflict)
+		assert.Equal(t, InitTemplatesStore(), mts)
+
+		require.NoError(t, mts.Merge(other, true))
+		assert.Equal(t, TemplateDorian(), mts[NameIonian])
+		assert.True(t, mts.Contains("Pelog"))
+	})
+}
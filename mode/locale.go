@@ -0,0 +1,154 @@
+package mode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-muse/muse/note"
+)
+
+// getLocalizedNames returns translations of the mode names by locales.
+// Modes without translation are named as in English.
+func getLocalizedNames() map[note.Locale]map[Name]string {
+	return map[note.Locale]map[Name]string{
+		note.LocaleRussian: {
+			NameNaturalMinor:        "натуральный минор",
+			NameHarmonicMinor:       "гармонический минор",
+			NameMelodicMinor:        "мелодический минор",
+			NameNaturalMajor:        "натуральный мажор",
+			NameHarmonicMajor:       "гармонический мажор",
+			NameMelodicMajor:        "мелодический мажор",
+			NameIonian:              "ионийский",
+			NameDorian:              "дорийский",
+			NamePhrygian:            "фригийский",
+			NameLydian:              "лидийский",
+			NameMixoLydian:          "миксолидийский",
+			NameAeolian:             "эолийский",
+			NameLocrian:             "локрийский",
+			NameLydianDominant:      "акустический лад",
+			NameSuperLocrian:        "альтерированный лад",
+			NameUkrainianDorian:     "украинский дорийский",
+			NamePhrygianDominant:    "фригийский доминантовый",
+			NameHungarianMajor:      "двойной гармонический мажор",
+			NameHungarianMinor:      "венгерский минор",
+			NamePentatonicMajor:     "мажорная пентатоника",
+			NamePentatonicMinor:     "минорная пентатоника",
+			NameWholeTone:           "целотонная гамма",
+			NameDiminishedHalfWhole: "гамма полутон-тон",
+			NameDiminishedWholeHalf: "гамма тон-полутон",
+			NameBluesHexatonic:      "блюзовая гамма",
+		},
+		note.LocaleItalian: {
+			NameNaturalMinor:        "minore naturale",
+			NameHarmonicMinor:       "minore armonica",
+			NameMelodicMinor:        "minore melodica",
+			NameNaturalMajor:        "maggiore naturale",
+			NameHarmonicMajor:       "maggiore armonica",
+			NameMelodicMajor:        "maggiore melodica",
+			NameIonian:              "ionico",
+			NameDorian:              "dorico",
+			NamePhrygian:            "frigio",
+			NameLydian:              "lidio",
+			NameMixoLydian:          "misolidio",
+			NameAeolian:             "eolio",
+			NameLocrian:             "locrio",
+			NameLydianDominant:      "lidio dominante",
+			NameSuperLocrian:        "superlocrio",
+			NamePhrygianDominant:    "frigio dominante",
+			NameHungarianMajor:      "doppia armonica maggiore",
+			NameHungarianMinor:      "minore ungherese",
+			NamePentatonicMajor:     "pentatonica maggiore",
+			NamePentatonicMinor:     "pentatonica minore",
+			NameWholeTone:           "scala esatonale",
+			NameDiminishedHalfWhole: "scala ottatonica semitono-tono",
+			NameDiminishedWholeHalf: "scala ottatonica tono-semitono",
+			NameBluesHexatonic:      "scala blues",
+		},
+	}
+}
+
+// getAliases returns the mode names by their common alternative English names.
+func getAliases() map[string]Name {
+	return map[string]Name{
+		"Major":               NameNaturalMajor,
+		"Minor":               NameNaturalMinor,
+		"Jazz Minor":          NameIonianFlat3,
+		"Dorian b2":           NamePhrygoDorian,
+		"Phrygian #6":         NamePhrygoDorian,
+		"Acoustic":            NameLydianDominant,
+		"Overtone":            NameLydianDominant,
+		"Lydian b7":           NameLydianDominant,
+		"Altered":             NameSuperLocrian,
+		"Lydian #5":           NameLydianAugmented,
+		"Mixolydian b6":       NameIonianAeolian,
+		"Locrian #2":          NameAeolianLydian,
+		"Half Diminished":     NameAeolianLydian,
+		"Locrian 6":           NameLocrianRais6,
+		"Ionian #5":           NameIonianRais5,
+		"Augmented Major":     NameIonianRais5,
+		"Dorian #4":           NameUkrainianDorian,
+		"Romanian Minor":      NameUkrainianDorian,
+		"Phrygian Major":      NamePhrygianDominant,
+		"Spanish Gypsy":       NamePhrygianDominant,
+		"Double Harmonic":     NameHungarianMajor,
+		"Gypsy Major":         NameHungarianMajor,
+		"Gypsy Minor":         NameHungarianMinor,
+		"Lydian Minor":        NameLydianDiminished,
+		"Blues":               NameBluesHexatonic,
+		"Dominant Diminished": NameDiminishedHalfWhole,
+		"Diminished":          NameDiminishedWholeHalf,
+	}
+}
+
+// normalizeName makes the name comparable with other spellings of it: lowercase without spaces, hyphens and underscores,
+// with the alterations written as "b" and "#", so "Ionian b3", "ionian-flat-3" and "IonianFlat3" are the same.
+func normalizeName(s string) string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer(" ", "", "-", "", "_", "", "♭", "b", "♯", "#").Replace(s)
+
+	return strings.NewReplacer("doubleflat", "bb", "flat", "b", "rais", "#", "sharp", "#").Replace(s)
+}
+
+// ParseName returns the mode name by its name in the locale, by its alias or by the name itself,
+// e.g. NameNaturalMinor for "Minor", NamePhrygoDorian for "Dorian b2" and NameDorian for "дорийский" in Russian.
+// Parsing ignores case, spaces and hyphens, and alterations may be written as "b", "#", "flat" or "sharp".
+func ParseName(s string, locale note.Locale) (Name, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	normalized := normalizeName(s)
+	for modeName, localized := range getLocalizedNames()[locale] {
+		if normalizeName(localized) == normalized {
+			return modeName, nil
+		}
+	}
+
+	for alias, modeName := range getAliases() {
+		if normalizeName(alias) == normalized {
+			return modeName, nil
+		}
+	}
+
+	for modeName := range InitTemplatesStore() {
+		if normalizeName(string(modeName)) == normalized {
+			return modeName, nil
+		}
+	}
+
+	return "", fmt.Errorf("parse mode name '%s' in locale '%s': %w", s, locale, ErrNameUnknown)
+}
+
+// Format returns the mode name in the locale, e.g. "дорийский" for Dorian in Russian or "minore armonica" for HarmonicMinor in Italian.
+// Modes without translation to the locale are named as in English.
+func (n Name) Format(locale note.Locale) (string, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	if localized, ok := getLocalizedNames()[locale][n]; ok {
+		return localized, nil
+	}
+
+	return string(n), nil
+}
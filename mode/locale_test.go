@@ -0,0 +1,105 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+)
+
+func TestParseName(t *testing.T) {
+	testCases := []struct {
+		s        string
+		locale   note.Locale
+		expected Name
+	}{
+		{s: "Minor", locale: note.LocaleEnglish, expected: NameNaturalMinor},
+		{s: "major", locale: note.LocaleGerman, expected: NameNaturalMajor},
+		{s: "Dorian b2", locale: note.LocaleEnglish, expected: NamePhrygoDorian},
+		{s: "Dorian ♭2", locale: note.LocaleEnglish, expected: NamePhrygoDorian},
+		{s: "Ionian b3", locale: note.LocaleEnglish, expected: NameIonianFlat3},
+		{s: "aeolian #7", locale: note.LocaleEnglish, expected: NameAeolianRais7},
+		{s: "Locrian bb7", locale: note.LocaleEnglish, expected: NameLocrianDoubleFlat7},
+		{s: "Harmonic-Minor", locale: note.LocaleEnglish, expected: NameHarmonicMinor},
+		{s: "MixoLydian", locale: note.LocaleItalian, expected: NameMixoLydian},
+		{s: "Altered", locale: note.LocaleSpanish, expected: NameSuperLocrian},
+		{s: "дорийский", locale: note.LocaleRussian, expected: NameDorian},
+		{s: "Гармонический минор", locale: note.LocaleRussian, expected: NameHarmonicMinor},
+		{s: "Minore armonica", locale: note.LocaleItalian, expected: NameHarmonicMinor},
+		{s: "frigio", locale: note.LocaleItalian, expected: NamePhrygian},
+	}
+
+	for _, testCase := range testCases {
+		parsed, err := ParseName(testCase.s, testCase.locale)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, parsed, "s: %s, locale: %s", testCase.s, testCase.locale)
+	}
+
+	_, err := ParseName("дорийский", note.LocaleItalian)
+	require.ErrorIs(t, err, ErrNameUnknown)
+
+	_, err = ParseName("Unknown", note.LocaleEnglish)
+	require.ErrorIs(t, err, ErrNameUnknown)
+
+	_, err = ParseName("Minor", "fr")
+	require.ErrorIs(t, err, note.ErrLocaleUnknown)
+
+	t.Run("ParseName: names, aliases and translations are not ambiguous", func(t *testing.T) {
+		normalized := make(map[string]Name)
+		check := func(s string, modeName Name) {
+			if other, ok := normalized[normalizeName(s)]; ok {
+				assert.Equal(t, other, modeName, "'%s' is ambiguous", s)
+			}
+			normalized[normalizeName(s)] = modeName
+		}
+
+		for modeName := range InitTemplatesStore() {
+			check(string(modeName), modeName)
+		}
+
+		for alias, modeName := range getAliases() {
+			check(alias, modeName)
+		}
+
+		for _, localized := range getLocalizedNames() {
+			for modeName, s := range localized {
+				check(s, modeName)
+			}
+		}
+	})
+
+	t.Run("ParseName: formatted names are parsed back", func(t *testing.T) {
+		for _, locale := range []note.Locale{note.LocaleEnglish, note.LocaleGerman, note.LocaleItalian, note.LocaleSpanish, note.LocaleRussian} {
+			for modeName := range InitTemplatesStore() {
+				formatted, err := modeName.Format(locale)
+				require.NoError(t, err)
+				parsed, err := ParseName(formatted, locale)
+				require.NoError(t, err)
+				assert.Equal(t, modeName, parsed, "formatted: %s, locale: %s", formatted, locale)
+			}
+		}
+	})
+}
+
+func TestName_Format(t *testing.T) {
+	formatted, err := NameDorian.Format(note.LocaleRussian)
+	require.NoError(t, err)
+	assert.Equal(t, "дорийский", formatted)
+
+	formatted, err = NameHarmonicMinor.Format(note.LocaleItalian)
+	require.NoError(t, err)
+	assert.Equal(t, "minore armonica", formatted)
+
+	formatted, err = NamePentatonicHirajoshi.Format(note.LocaleRussian)
+	require.NoError(t, err)
+	assert.Equal(t, string(NamePentatonicHirajoshi), formatted)
+
+	formatted, err = NameDorian.Format(note.LocaleGerman)
+	require.NoError(t, err)
+	assert.Equal(t, string(NameDorian), formatted)
+
+	_, err = NameDorian.Format("fr")
+	require.ErrorIs(t, err, note.ErrLocaleUnknown)
+}
@@ -20,6 +20,10 @@ type Mode struct {
 
 // Name returns mode's name.
 func (m *Mode) Name() Name {
+	if m == nil {
+		return ""
+	}
+
 	return m.name
 }
 
@@ -91,3 +91,91 @@ func ExampleMode_GenerateScale() {
 	fmt.Println(scale)
 	// Output: [Eb F G A Bb C Db]
 }
+
+// Scales of several octaves get octaves of the notes changed at C, and the melodic minor descends as the natural minor.
+func ExampleMode_GenerateScaleOctaves() {
+	m := mode.MustMakeNewMode(mode.NameMelodicMinor, note.A)
+
+	scl, err := m.GenerateScaleOctaves(note.MustNewNoteWithOctave(note.A, 3), 1, mode.ScaleAscendingDescending)
+	if err != nil {
+		panic(err)
+	}
+
+	names := make([]string, 0, len(scl))
+	for _, n := range scl {
+		names = append(names, fmt.Sprintf("%s%d", n.Name(), n.Octave().Number()))
+	}
+	fmt.Println(names)
+	// Output: [A3 B3 C4 D4 E4 F#4 G#4 A4 G4 F4 E4 D4 C4 B3 A3]
+}
+
+// Melody can be moved by steps of the mode, so the intervals between the notes follow the mode.
+func ExampleMode_TransposeNotesDiatonically() {
+	cMajor := mode.MustMakeNewMode(mode.NameNaturalMajor, note.C)
+
+	// Major triad moved up a second becomes minor one
+	transposed, err := cMajor.TransposeNotesDiatonically(note.MustNewNotesFromNoteNames(note.C, note.E, note.G), 1, mode.OutOfModeError)
+	if err != nil {
+		panic(err)
+	}
+
+	sequence, err := cMajor.GenerateSequence(note.MustNewNotesFromNoteNames(note.C, note.D, note.E), 1, 3, mode.OutOfModeError)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(transposed)
+	fmt.Println(sequence)
+	// Output:
+	// [D F A]
+	// [C D E D E F E F G]
+}
+
+// Modes of limited transposition have the same pitch classes when built from several tonics.
+func ExampleTemplate_Transpositions() {
+	for _, modeName := range []mode.Name{mode.NameIonian, mode.NameWholeTone, mode.NameDiminishedWholeHalf, mode.NameMessiaenMode4} {
+		template, _ := mode.GetTemplateByName(modeName)
+		fmt.Println(modeName, template.Transpositions(), template.IsLimitedTransposition(), template.EquivalentTonics(note.C.MustMakeNote()))
+	}
+	// Output:
+	// Ionian 12 false [C]
+	// WholeTone 2 true [C D E F# Ab Bb]
+	// DiminishedWholeHalf 3 true [C Eb Gb A]
+	// MessiaenMode4 6 true [C F#]
+}
+
+// Mode names are resolved by aliases and translations and formatted in the locale.
+func ExampleParseName() {
+	for _, s := range []string{"Minor", "Dorian b2", "Ionian #5"} {
+		modeName, _ := mode.ParseName(s, note.LocaleEnglish)
+		fmt.Println(s, "->", modeName)
+	}
+
+	modeName, _ := mode.ParseName("миксолидийский", note.LocaleRussian)
+	italian, _ := modeName.Format(note.LocaleItalian)
+	fmt.Println(modeName, italian)
+	// Output: Minor -> NaturalMinor
+	// Dorian b2 -> PhrygoDorian
+	// Ionian #5 -> IonianRais5
+	// MixoLydian misolidio
+}
+
+// Scale degrees and movable-do syllables of a melody are counted from the tonic of the mode, including the notes outside the mode.
+func ExampleMode_MovableDo() {
+	m := mode.MustMakeNewMode(mode.NameDorian, note.D)
+	melody := note.MustNewNotesFromNoteNames(note.D, note.F, note.GSHARP, note.A, note.B, note.C, note.CSHARP, note.D)
+
+	degrees, _ := m.ScaleDegrees(melody)
+	syllables, _ := m.MovableDo(melody)
+	for i, sd := range degrees {
+		fmt.Println(melody[i].Name(), sd, syllables[i], sd.InMode)
+	}
+	// Output: D 1 do true
+	// F b3 me true
+	// G# #4 fi false
+	// A 5 sol true
+	// B 6 la true
+	// C b7 te true
+	// C# 7 ti false
+	// D 1 do true
+}
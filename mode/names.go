@@ -90,3 +90,39 @@ const (
 	NamePentatonicHonKumoiJoshi    = Name("PentatonicHonKumoiJoshi")
 	NamePentatonicLydianPentatonic = Name("PentatonicLydianPentatonic") // Augmented, Raga Amritavarshini (Chinese)
 )
+
+// Hexatonic modes
+
+const (
+	NameWholeTone      = Name("WholeTone")      // Messiaen's first mode of limited transposition
+	NameAugmented      = Name("Augmented")      // Alternating minor thirds and halftones
+	NameBluesHexatonic = Name("BluesHexatonic") // Minor pentatonic with the flattened fifth
+)
+
+// Octatonic modes
+
+const (
+	NameDiminishedHalfWhole = Name("DiminishedHalfWhole") // Dominant diminished, Messiaen's second mode of limited transposition
+	NameDiminishedWholeHalf = Name("DiminishedWholeHalf") // Diminished
+)
+
+// Bebop modes
+
+const (
+	NameBebopDominant     = Name("BebopDominant")     // MixoLydian with the passing major seventh
+	NameBebopMajor        = Name("BebopMajor")        // Ionian with the passing minor sixth
+	NameBebopDorian       = Name("BebopDorian")       // Dorian with the passing major third
+	NameBebopMelodicMinor = Name("BebopMelodicMinor") // Melodic minor with the passing minor sixth
+)
+
+// Messiaen's modes of limited transposition
+
+const (
+	NameMessiaenMode1 = Name("MessiaenMode1") // Whole tone
+	NameMessiaenMode2 = Name("MessiaenMode2") // Diminished half-whole
+	NameMessiaenMode3 = Name("MessiaenMode3")
+	NameMessiaenMode4 = Name("MessiaenMode4")
+	NameMessiaenMode5 = Name("MessiaenMode5")
+	NameMessiaenMode6 = Name("MessiaenMode6")
+	NameMessiaenMode7 = Name("MessiaenMode7")
+)
@@ -1,7 +1,13 @@
 package mode
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+
 	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
 	"github.com/go-muse/muse/scale"
 )
 
@@ -28,3 +34,172 @@ func (m *Mode) GenerateScale(desc bool) scale.Scale {
 
 	return scl
 }
+
+// ScaleDirection defines the direction of the scale generated within a range.
+type ScaleDirection uint8
+
+const (
+	// ScaleAscending goes from the lowest note of the range to the highest one.
+	ScaleAscending ScaleDirection = iota
+	// ScaleDescending goes from the highest note of the range to the lowest one.
+	ScaleDescending
+	// ScaleAscendingDescending goes up and then back down, the highest note is not repeated.
+	ScaleAscendingDescending
+)
+
+// ErrScaleRangeInvalid is returned when the range of the scale has no octaves or its bounds are reversed.
+var ErrScaleRangeInvalid = errors.New("invalid scale range")
+
+// GenerateScaleOctaves generates the scale of the given amount of octaves from the start note.
+// The start note must have an octave and be a note of the mode. Octaves of the notes change when the scale passes C,
+// so C major from G4 is G4 A4 B4 C5 D5 E5 F5 G5. The descending part of melodic minor is the natural minor.
+func (m *Mode) GenerateScaleOctaves(start *note.Note, octaves uint8, direction ScaleDirection) (scale.Scale, error) {
+	if start == nil || start.Octave() == nil || octaves == 0 {
+		return nil, fmt.Errorf("generate scale of %d octaves in mode '%s': %w", octaves, m.Name(), ErrScaleRangeInvalid)
+	}
+
+	if m.findDegreeByNote(start) == nil {
+		return nil, fmt.Errorf("generate scale from note '%s' in mode '%s': %w", start.Name(), m.Name(), ErrNoteNotInMode)
+	}
+
+	bottom, top := start.Copy(), start.Copy()
+	if direction == ScaleDescending {
+		oct, err := octave.NewByNumber(start.Octave().Number() - octave.Number(octaves)) //nolint:gosec // checked by octave constructor
+		if err != nil {
+			return nil, fmt.Errorf("generate scale of %d octaves down from '%s': %w: %w", octaves, start.Name(), ErrScaleRangeInvalid, err)
+		}
+		bottom.SetOctave(oct)
+	} else {
+		oct, err := octave.NewByNumber(start.Octave().Number() + octave.Number(octaves)) //nolint:gosec // checked by octave constructor
+		if err != nil {
+			return nil, fmt.Errorf("generate scale of %d octaves up from '%s': %w: %w", octaves, start.Name(), ErrScaleRangeInvalid, err)
+		}
+		top.SetOctave(oct)
+	}
+
+	return m.generateScaleBetween(bottom, top, direction, true)
+}
+
+// GenerateScaleBetween generates the scale of the notes of the mode between the low and the high notes including them.
+// The bounds must have octaves, but may be out of the mode. The descending part of melodic minor is the natural minor.
+func (m *Mode) GenerateScaleBetween(low, high *note.Note, direction ScaleDirection) (scale.Scale, error) {
+	return m.generateScaleBetween(low, high, direction, false)
+}
+
+// generateScaleBetween generates the scale between the notes. If the scale is bounded by the notes,
+// its descending part starts and ends with them even if they are out of the descending form of the mode.
+func (m *Mode) generateScaleBetween(low, high *note.Note, direction ScaleDirection, bounded bool) (scale.Scale, error) {
+	if low == nil || high == nil || low.Octave() == nil || high.Octave() == nil || low.Compare(high) > 0 {
+		return nil, fmt.Errorf("generate scale in mode '%s': %w", m.Name(), ErrScaleRangeInvalid)
+	}
+
+	var ascending, descending scale.Scale
+	if direction != ScaleDescending {
+		notes, err := m.notesBetween(low, high)
+		if err != nil {
+			return nil, err
+		}
+		ascending = notes
+	}
+
+	if direction != ScaleAscending {
+		notes, err := m.descendingForm().notesBetween(low, high)
+		if err != nil {
+			return nil, err
+		}
+
+		slices.Reverse(notes)
+		if bounded && (len(notes) == 0 || !notes[0].IsEqual(high)) {
+			notes = append(scale.Scale{high.Copy()}, notes...)
+		}
+		if bounded && !notes[len(notes)-1].IsEqual(low) {
+			notes = append(notes, low.Copy())
+		}
+		descending = notes
+	}
+
+	// The highest note is not repeated on the way back
+	if len(ascending) > 0 && len(descending) > 0 && ascending[len(ascending)-1].IsEqual(descending[0]) {
+		descending = descending[1:]
+	}
+
+	return append(ascending, descending...), nil
+}
+
+// descendingForm returns the mode used for the descending scales: the natural minor for the melodic minor and the mode itself otherwise.
+func (m *Mode) descendingForm() *Mode {
+	if m.Name() != NameMelodicMinor || m.GetFirstDegree() == nil {
+		return m
+	}
+
+	return newModeBuilder(TemplateNaturalMinor()).build(NameNaturalMinor, m.GetFirstDegree().Note().Copy())
+}
+
+// notesBetween returns the ascending notes of the mode with octaves between the low and the high notes including them.
+// The scale never steps beyond the bounds, so the range may touch the lowest and the highest octaves.
+func (m *Mode) notesBetween(low, high *note.Note) (scale.Scale, error) {
+	d := m.GetFirstDegree()
+	if d == nil || d.Note() == nil {
+		return nil, fmt.Errorf("generate scale in mode '%s': %w", m.Name(), ErrDegreeNumberInvalid)
+	}
+
+	// Going down from the tonic in the octave of the low note to the lowest note of the mode at or above the low note
+	current := d.Note().Copy().SetOctave(low.Octave())
+	for {
+		previous, below, err := stepWithOctave(d, current, -1)
+		if errors.Is(err, octave.ErrOctaveNumberUnknown) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("generate scale in mode '%s': %w: %w", m.Name(), ErrScaleRangeInvalid, err)
+		}
+
+		if below.Compare(low) < 0 {
+			break
+		}
+		d, current = previous, below
+	}
+
+	// The tonic may be below the low note, then going up to the range
+	for current.Compare(low) < 0 {
+		var err error
+		if d, current, err = stepWithOctave(d, current, 1); err != nil {
+			return nil, fmt.Errorf("generate scale in mode '%s': %w: %w", m.Name(), ErrScaleRangeInvalid, err)
+		}
+	}
+
+	notes := make(scale.Scale, 0)
+	for current.Compare(high) <= 0 {
+		notes = append(notes, current)
+
+		var err error
+		d, current, err = stepWithOctave(d, current, 1)
+		if errors.Is(err, octave.ErrOctaveNumberUnknown) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("generate scale in mode '%s': %w: %w", m.Name(), ErrScaleRangeInvalid, err)
+		}
+	}
+
+	return notes, nil
+}
+
+// stepWithOctave moves the note of the degree by one step of the mode up or down keeping its octave right.
+func stepWithOctave(d *degree.Degree, n *note.Note, steps int) (*degree.Degree, *note.Note, error) {
+	halfTones, letters, err := stepsBetweenDegrees(d, steps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := n.TransposeBySpelling(halfTones, letters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("step from note '%s': %w", n.Name(), err)
+	}
+
+	if steps < 0 {
+		return d.GetPrevious(), next, nil
+	}
+
+	return d.GetForwardDegreeByDegreeNum(1), next, nil
+}
@@ -1,9 +1,12 @@
 package mode
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/go-muse/muse/note"
 	"github.com/go-muse/muse/scale"
@@ -64,3 +67,140 @@ func TestGenerateScale(t *testing.T) {
 		})
 	}
 }
+
+// scaleWithOctaves returns names of the notes of the scale with their octaves, e.g. "C4".
+func scaleWithOctaves(s scale.Scale) []string {
+	names := make([]string, 0, len(s))
+	for _, n := range s {
+		names = append(names, fmt.Sprintf("%s%d", n.Name(), n.Octave().Number()))
+	}
+
+	return names
+}
+
+func TestMode_GenerateScaleOctaves(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mode      *Mode
+		start     *note.Note
+		octaves   uint8
+		direction ScaleDirection
+		expected  string
+	}{
+		{
+			name: "C major ascending from G", mode: MustMakeNewMode(NameNaturalMajor, note.C),
+			start: note.MustNewNoteWithOctave(note.G, 4), octaves: 1, direction: ScaleAscending,
+			expected: "G4 A4 B4 C5 D5 E5 F5 G5",
+		},
+		{
+			name: "B Locrian two octaves descending", mode: MustMakeNewMode(NameLocrian, note.B),
+			start: note.MustNewNoteWithOctave(note.B, 4), octaves: 2, direction: ScaleDescending,
+			expected: "B4 A4 G4 F4 E4 D4 C4 B3 A3 G3 F3 E3 D3 C3 B2",
+		},
+		{
+			name: "C# major with B# ascending and descending", mode: MustMakeNewMode(NameNaturalMajor, note.CSHARP),
+			start: note.MustNewNoteWithOctave(note.CSHARP, 3), octaves: 1, direction: ScaleAscendingDescending,
+			expected: "C#3 D#3 E#3 F#3 G#3 A#3 B#3 C#4 B#3 A#3 G#3 F#3 E#3 D#3 C#3",
+		},
+		{
+			name: "A melodic minor descends as natural minor", mode: MustMakeNewMode(NameMelodicMinor, note.A),
+			start: note.MustNewNoteWithOctave(note.A, 3), octaves: 1, direction: ScaleAscendingDescending,
+			expected: "A3 B3 C4 D4 E4 F#4 G#4 A4 G4 F4 E4 D4 C4 B3 A3",
+		},
+		{
+			name: "A melodic minor from F# keeps the bounds", mode: MustMakeNewMode(NameMelodicMinor, note.A),
+			start: note.MustNewNoteWithOctave(note.FSHARP, 4), octaves: 1, direction: ScaleDescending,
+			expected: "F#4 F4 E4 D4 C4 B3 A3 G3 F#3",
+		},
+		{
+			name: "Jazz minor descends as itself", mode: MustMakeNewMode(NameIonianFlat3, note.A),
+			start: note.MustNewNoteWithOctave(note.A, 4), octaves: 1, direction: ScaleDescending,
+			expected: "A4 G#4 F#4 E4 D4 C4 B3 A3",
+		},
+		{
+			name: "Pentatonic two octaves", mode: MustMakeNewMode(NamePentatonicMinor, note.E),
+			start: note.MustNewNoteWithOctave(note.E, 2), octaves: 2, direction: ScaleAscending,
+			expected: "E2 G2 A2 B2 D3 E3 G3 A3 B3 D4 E4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := testCase.mode.GenerateScaleOctaves(testCase.start, testCase.octaves, testCase.direction)
+			require.NoError(t, err)
+			assert.Equal(t, strings.Fields(testCase.expected), scaleWithOctaves(s))
+		})
+	}
+
+	t.Run("GenerateScaleOctaves: invalid arguments", func(t *testing.T) {
+		m := MustMakeNewMode(NameNaturalMajor, note.C)
+		_, err := m.GenerateScaleOctaves(note.C.MustNewNote(), 1, ScaleAscending)
+		require.ErrorIs(t, err, ErrScaleRangeInvalid)
+
+		_, err = m.GenerateScaleOctaves(note.MustNewNoteWithOctave(note.C, 4), 0, ScaleAscending)
+		require.ErrorIs(t, err, ErrScaleRangeInvalid)
+
+		_, err = m.GenerateScaleOctaves(note.MustNewNoteWithOctave(note.CSHARP, 4), 1, ScaleAscending)
+		require.ErrorIs(t, err, ErrNoteNotInMode)
+
+		_, err = m.GenerateScaleOctaves(note.MustNewNoteWithOctave(note.C, 8), 3, ScaleAscending)
+		require.ErrorIs(t, err, ErrScaleRangeInvalid)
+	})
+}
+
+func TestMode_GenerateScaleBetween(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mode      *Mode
+		low, high *note.Note
+		direction ScaleDirection
+		expected  string
+	}{
+		{
+			name: "D Dorian between bounds out of the mode", mode: MustMakeNewMode(NameDorian, note.D),
+			low: note.MustNewNoteWithOctave(note.FSHARP, 3), high: note.MustNewNoteWithOctave(note.EFLAT, 4), direction: ScaleAscending,
+			expected: "G3 A3 B3 C4 D4",
+		},
+		{
+			name: "G major ascending and descending", mode: MustMakeNewMode(NameNaturalMajor, note.G),
+			low: note.MustNewNoteWithOctave(note.E, 4), high: note.MustNewNoteWithOctave(note.C, 5), direction: ScaleAscendingDescending,
+			expected: "E4 F#4 G4 A4 B4 C5 B4 A4 G4 F#4 E4",
+		},
+		{
+			name: "A melodic minor with the different top notes", mode: MustMakeNewMode(NameMelodicMinor, note.A),
+			low: note.MustNewNoteWithOctave(note.E, 4), high: note.MustNewNoteWithOctave(note.GSHARP, 4), direction: ScaleAscendingDescending,
+			expected: "E4 F#4 G#4 G4 F4 E4",
+		},
+		{
+			name: "Single note", mode: MustMakeNewMode(NameNaturalMajor, note.C),
+			low: note.MustNewNoteWithOctave(note.C, 4), high: note.MustNewNoteWithOctave(note.C, 4), direction: ScaleAscendingDescending,
+			expected: "C4",
+		},
+		{
+			name: "The lowest octave", mode: MustMakeNewMode(NameNaturalMajor, note.C),
+			low: note.MustNewNoteWithOctave(note.C, -1), high: note.MustNewNoteWithOctave(note.C, 0), direction: ScaleAscendingDescending,
+			expected: "C-1 D-1 E-1 F-1 G-1 A-1 B-1 C0 B-1 A-1 G-1 F-1 E-1 D-1 C-1",
+		},
+		{
+			name: "The highest octaves", mode: MustMakeNewMode(NameNaturalMajor, note.C),
+			low: note.MustNewNoteWithOctave(note.C, 8), high: note.MustNewNoteWithOctave(note.B, 9), direction: ScaleAscending,
+			expected: "C8 D8 E8 F8 G8 A8 B8 C9 D9 E9 F9 G9 A9 B9",
+		},
+		{
+			name: "A minor from below the tonic", mode: MustMakeNewMode(NameNaturalMinor, note.A),
+			low: note.MustNewNoteWithOctave(note.C, 4), high: note.MustNewNoteWithOctave(note.E, 4), direction: ScaleDescending,
+			expected: "E4 D4 C4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := testCase.mode.GenerateScaleBetween(testCase.low, testCase.high, testCase.direction)
+			require.NoError(t, err)
+			assert.Equal(t, strings.Fields(testCase.expected), scaleWithOctaves(s))
+		})
+	}
+
+	_, err := MustMakeNewMode(NameNaturalMajor, note.C).GenerateScaleBetween(note.MustNewNoteWithOctave(note.C, 5), note.MustNewNoteWithOctave(note.C, 4), ScaleAscending)
+	require.ErrorIs(t, err, ErrScaleRangeInvalid)
+}
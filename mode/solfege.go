@@ -0,0 +1,170 @@
+package mode
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/degree"
+	"github.com/go-muse/muse/note"
+)
+
+var (
+	// ErrScaleDegreeUnknown is returned when the scale degree of the note can't be defined, e.g. for microtonal notes.
+	ErrScaleDegreeUnknown = errors.New("unknown scale degree")
+	// ErrSyllableUnknown is returned when the scale degree has no movable-do syllable, e.g. b1 or #7.
+	ErrSyllableUnknown = errors.New("unknown solfège syllable")
+)
+
+// ScaleDegree is the position of the note relative to the tonic of the mode: the number of the degree counted by letter names
+// and its alteration in halftones relative to the major scale built from the tonic, e.g. b3 or #4.
+type ScaleDegree struct {
+	Number     degree.Number
+	Alteration int8
+	// InMode is true if the note is a degree of the mode.
+	InMode bool
+}
+
+// String returns the scale degree with its accidentals, e.g. "3", "b3", "#4" or "bb7".
+func (sd ScaleDegree) String() string {
+	accidental := note.NewAccidentalByQuarterTones(sd.Alteration * note.QuarterTonesInHalfTone)
+
+	return fmt.Sprintf("%s%d", accidental, sd.Number)
+}
+
+// getMovableDoSyllables returns the chromatic movable-do syllables by scale degree numbers and alterations.
+func getMovableDoSyllables() map[degree.Number]map[int8]string {
+	return map[degree.Number]map[int8]string{
+		1: {0: "do", 1: "di"},
+		2: {-1: "ra", 0: "re", 1: "ri"},
+		3: {-1: "me", 0: "mi"},
+		4: {0: "fa", 1: "fi"},
+		5: {-1: "se", 0: "sol", 1: "si"},
+		6: {-1: "le", 0: "la", 1: "li"},
+		7: {-1: "te", 0: "ti"},
+	}
+}
+
+// Syllable returns the movable-do syllable of the scale degree with do on the tonic of any mode,
+// so the minor third is "me" and the raised fourth is "fi".
+func (sd ScaleDegree) Syllable() (string, error) {
+	if syllable, ok := getMovableDoSyllables()[sd.Number][sd.Alteration]; ok {
+		return syllable, nil
+	}
+
+	return "", fmt.Errorf("scale degree '%s': %w", sd, ErrSyllableUnknown)
+}
+
+// alterationOfCharacteristic returns the alteration in halftones of the degree with the modal characteristic
+// relative to the major scale: minor third is lowered by a halftone, diminished fifth too, and diminished seventh by two halftones.
+func alterationOfCharacteristic(degreeNum degree.Number, characteristic degree.CharacteristicName) (int8, bool) {
+	perfect := map[degree.CharacteristicName]int8{
+		degree.Characteristic3xDim: -3,
+		degree.Characteristic2xDim: -2,
+		degree.CharacteristicDim:   -1,
+		degree.CharacteristicClean: 0,
+		degree.CharacteristicAug:   1,
+		degree.Characteristic2xAug: 2,
+		degree.Characteristic3xAug: 3,
+	}
+	imperfect := map[degree.CharacteristicName]int8{
+		degree.Characteristic2xDim: -3,
+		degree.CharacteristicDim:   -2,
+		degree.CharacteristicMinor: -1,
+		degree.CharacteristicMajor: 0,
+		degree.CharacteristicAug:   1,
+		degree.Characteristic2xAug: 2,
+	}
+
+	switch degreeNum {
+	case 1, 4, 5: //nolint:mnd
+		alteration, ok := perfect[characteristic]
+
+		return alteration, ok
+	default:
+		alteration, ok := imperfect[characteristic]
+
+		return alteration, ok
+	}
+}
+
+// ScaleDegreeOf returns the scale degree of the note relative to the tonic of the mode.
+// In seven-degree modes the alteration is taken from the modal characteristics of the tonic, and the notes outside the mode
+// are altered relative to the degree with the same letter name: F# in C Ionian is #4 and E in C Dorian is 3.
+// In other modes the alteration is counted from the major scale built from the tonic.
+func (m *Mode) ScaleDegreeOf(n *note.Note) (ScaleDegree, error) {
+	tonic := m.GetFirstDegree()
+	if tonic == nil || n == nil {
+		return ScaleDegree{}, fmt.Errorf("scale degree in mode '%s': %w", m.Name(), ErrScaleDegreeUnknown)
+	}
+
+	if n.IsMicrotonal() || tonic.Note().IsMicrotonal() {
+		return ScaleDegree{}, fmt.Errorf("scale degree of note '%s' in mode '%s': %w", n.Name(), m.Name(), ErrScaleDegreeUnknown)
+	}
+
+	const lettersInOctave = 7
+	letters := (n.BaseNameIndex() - tonic.Note().BaseNameIndex() + lettersInOctave) % lettersInOctave
+	sd := ScaleDegree{Number: degree.Number(letters + 1), InMode: m.Contains(n)} //nolint:gosec // letters are less than seven
+
+	if d := m.findDegreeByBaseName(n); d != nil {
+		for _, mc := range tonic.ModalCharacteristics() {
+			if mc.Degree() != d {
+				continue
+			}
+
+			alteration, ok := alterationOfCharacteristic(sd.Number, mc.Name())
+			if !ok {
+				return ScaleDegree{}, fmt.Errorf("scale degree of note '%s' in mode '%s': %w", n.Name(), m.Name(), ErrScaleDegreeUnknown)
+			}
+
+			sd.Alteration = alteration + n.GetAlterationShift() - d.Note().GetAlterationShift()
+
+			return sd, nil
+		}
+	}
+
+	// Modes without modal characteristics are compared with the major scale
+	major, err := tonic.Note().TransposeBySpelling(int(TemplateIonian().GetHalftonesByDegreeNum(sd.Number-1)), letters)
+	if err != nil {
+		return ScaleDegree{}, fmt.Errorf("scale degree of note '%s' in mode '%s': %w: %w", n.Name(), m.Name(), ErrScaleDegreeUnknown, err)
+	}
+	sd.Alteration = n.GetAlterationShift() - major.GetAlterationShift()
+
+	return sd, nil
+}
+
+// ScaleDegrees returns the scale degrees of the notes relative to the tonic of the mode, e.g. for C Dorian
+// the melody C D Eb F# G is 1 2 b3 #4 5.
+func (m *Mode) ScaleDegrees(notes note.Notes) ([]ScaleDegree, error) {
+	degrees := make([]ScaleDegree, 0, len(notes))
+	for _, n := range notes {
+		sd, err := m.ScaleDegreeOf(n)
+		if err != nil {
+			return nil, err
+		}
+
+		degrees = append(degrees, sd)
+	}
+
+	return degrees, nil
+}
+
+// MovableDo returns the movable-do syllables of the notes with do on the tonic of the mode,
+// e.g. for A Aeolian the melody A B C D# E is do re me fi sol.
+func (m *Mode) MovableDo(notes note.Notes) ([]string, error) {
+	degrees, err := m.ScaleDegrees(notes)
+	if err != nil {
+		return nil, err
+	}
+
+	syllables := make([]string, 0, len(degrees))
+	for _, sd := range degrees {
+		syllable, err := sd.Syllable()
+		if err != nil {
+			return nil, fmt.Errorf("movable-do in mode '%s': %w", m.Name(), err)
+		}
+
+		syllables = append(syllables, syllable)
+	}
+
+	return syllables, nil
+}
@@ -0,0 +1,142 @@
+package mode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/note"
+)
+
+func TestMode_ScaleDegreeOf(t *testing.T) {
+	testCases := []struct {
+		modeName Name
+		tonic    note.Name
+		notes    string
+		expected []string
+		inMode   []bool
+	}{
+		{
+			modeName: NameIonian, tonic: note.C, notes: "C D E F G A B",
+			expected: []string{"1", "2", "3", "4", "5", "6", "7"},
+			inMode:   []bool{true, true, true, true, true, true, true},
+		},
+		{
+			modeName: NameDorian, tonic: note.C, notes: "C D Eb E F# G Bb",
+			expected: []string{"1", "2", "b3", "3", "#4", "5", "b7"},
+			inMode:   []bool{true, true, true, false, false, true, true},
+		},
+		{
+			modeName: NameLocrian, tonic: note.B, notes: "B C D F F# G A",
+			expected: []string{"1", "b2", "b3", "b5", "5", "b6", "b7"},
+			inMode:   []bool{true, true, true, true, false, true, true},
+		},
+		{
+			modeName: NameUltraLocrian, tonic: note.C, notes: "Db Bbb B",
+			expected: []string{"b2", "bb7", "7"},
+			inMode:   []bool{true, true, false},
+		},
+		{
+			modeName: NamePentatonicMinor, tonic: note.A, notes: "A C D E G G# F",
+			expected: []string{"1", "b3", "4", "5", "b7", "7", "b6"},
+			inMode:   []bool{true, true, true, true, true, false, false},
+		},
+		{
+			modeName: NameWholeTone, tonic: note.FSHARP, notes: "F# G# A# B# D E C B",
+			expected: []string{"1", "2", "3", "#4", "b6", "b7", "b5", "4"},
+			inMode:   []bool{true, true, true, true, true, true, false, false},
+		},
+	}
+
+	for _, testCase := range testCases {
+		m := testCase.modeName.MustMakeNewMode(testCase.tonic)
+		degrees, err := m.ScaleDegrees(notesFromString(testCase.notes))
+		require.NoError(t, err)
+
+		formatted := make([]string, 0, len(degrees))
+		inMode := make([]bool, 0, len(degrees))
+		for _, sd := range degrees {
+			formatted = append(formatted, sd.String())
+			inMode = append(inMode, sd.InMode)
+		}
+
+		assert.Equal(t, testCase.expected, formatted, "mode: %s %s", testCase.tonic, testCase.modeName)
+		assert.Equal(t, testCase.inMode, inMode, "mode: %s %s", testCase.tonic, testCase.modeName)
+	}
+
+	t.Run("ScaleDegreeOf: modal characteristics give the same result as the major scale", func(t *testing.T) {
+		for _, modeName := range []Name{NameIonian, NameAeolian, NameHarmonicMinor, NameSuperLocrian, NameHungarianMinor} {
+			for _, tonic := range note.GetSetFullChromatic() {
+				m := modeName.MustMakeNewMode(tonic.Name())
+				major := NameIonian.MustMakeNewMode(tonic.Name())
+				for d := range major.IterateOneRound(false) {
+					for _, alteration := range []int8{-1, 0, 1} {
+						n, err := d.Note().TransposeBySpelling(int(alteration), 0)
+						if err != nil {
+							continue
+						}
+
+						sd, err := m.ScaleDegreeOf(n)
+						require.NoError(t, err)
+						assert.Equal(t, d.Number(), sd.Number, "mode: %s %s, note: %s", tonic.Name(), modeName, n.Name())
+						assert.Equal(t, alteration, sd.Alteration, "mode: %s %s, note: %s", tonic.Name(), modeName, n.Name())
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("ScaleDegreeOf: unknown scale degrees", func(t *testing.T) {
+		m := NameIonian.MustMakeNewMode(note.C)
+		_, err := m.ScaleDegreeOf(note.MustNewNote(note.EHALFFLAT))
+		require.ErrorIs(t, err, ErrScaleDegreeUnknown)
+
+		_, err = m.ScaleDegreeOf(nil)
+		require.ErrorIs(t, err, ErrScaleDegreeUnknown)
+
+		var empty *Mode
+		_, err = empty.ScaleDegreeOf(note.C.MustNewNote())
+		require.ErrorIs(t, err, ErrScaleDegreeUnknown)
+	})
+}
+
+func TestMode_MovableDo(t *testing.T) {
+	testCases := []struct {
+		modeName Name
+		tonic    note.Name
+		notes    string
+		expected []string
+	}{
+		{modeName: NameNaturalMajor, tonic: note.G, notes: "G A B C D E F# G", expected: []string{"do", "re", "mi", "fa", "sol", "la", "ti", "do"}},
+		{modeName: NameAeolian, tonic: note.A, notes: "A B C D# E F G G#", expected: []string{"do", "re", "me", "fi", "sol", "le", "te", "ti"}},
+		{modeName: NamePhrygian, tonic: note.E, notes: "E F G A B C D", expected: []string{"do", "ra", "me", "fa", "sol", "le", "te"}},
+		{modeName: NameIonian, tonic: note.C, notes: "C C# D D# F F# G G# A A# Bb Ab Gb Eb Db", expected: []string{
+			"do", "di", "re", "ri", "fa", "fi", "sol", "si", "la", "li", "te", "le", "se", "me", "ra",
+		}},
+	}
+
+	for _, testCase := range testCases {
+		m := testCase.modeName.MustMakeNewMode(testCase.tonic)
+		syllables, err := m.MovableDo(notesFromString(testCase.notes))
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, syllables, "mode: %s %s", testCase.tonic, testCase.modeName)
+	}
+
+	_, err := NameIonian.MustMakeNewMode(note.C).MovableDo(notesFromString("C Cb"))
+	require.ErrorIs(t, err, ErrSyllableUnknown)
+
+	_, err = NameIonian.MustMakeNewMode(note.C).MovableDo(notesFromString("C E#"))
+	require.ErrorIs(t, err, ErrSyllableUnknown)
+}
+
+// notesFromString makes notes from their names separated by spaces.
+func notesFromString(s string) note.Notes {
+	notes := make(note.Notes, 0)
+	for _, name := range strings.Fields(s) {
+		notes = append(notes, note.MustNewNote(note.Name(name)))
+	}
+
+	return notes
+}
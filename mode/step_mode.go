@@ -0,0 +1,130 @@
+package mode
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/interval"
+)
+
+// StepMode is a mode in an equal division of the octave other than twelve-tone.
+// Its template contains steps of the division instead of halftones.
+// Notes of such modes can't be spelled with the usual note names, so the mode is described by steps, cents and frequencies.
+type StepMode struct {
+	name     Name
+	edo      halftone.EDO
+	template Template
+}
+
+// NewStepMode creates a mode from the template containing steps of the given division of the octave.
+func NewStepMode(modeName Name, edo halftone.EDO, modeTemplate Template) (*StepMode, error) {
+	if err := edo.Validate(); err != nil {
+		return nil, fmt.Errorf("create step mode '%s': %w", modeName, err)
+	}
+
+	if err := modeTemplate.ValidateEDO(edo); err != nil {
+		return nil, fmt.Errorf("validate mode template to create step mode '%s': %w", modeName, err)
+	}
+
+	return &StepMode{name: modeName, edo: edo, template: modeTemplate}, nil
+}
+
+// MakeNewStepMode creates a step mode by the name of a twelve-tone mode converted to the given division of the octave.
+func MakeNewStepMode(modeName Name, edo halftone.EDO) (*StepMode, error) {
+	modeTemplate, err := GetTemplateByName(modeName)
+	if err != nil {
+		return nil, fmt.Errorf("get template to create step mode by mode name '%s': %w", modeName, err)
+	}
+
+	converted, err := modeTemplate.ToEDO(edo)
+	if err != nil {
+		return nil, fmt.Errorf("convert template of mode '%s': %w", modeName, err)
+	}
+
+	return NewStepMode(modeName, edo, converted)
+}
+
+// Name returns mode's name.
+func (sm *StepMode) Name() Name {
+	if sm == nil {
+		return ""
+	}
+
+	return sm.name
+}
+
+// EDO returns the division of the octave of the mode.
+func (sm *StepMode) EDO() halftone.EDO {
+	if sm == nil {
+		return 0
+	}
+
+	return sm.edo
+}
+
+// Template returns a copy of the mode template in steps of the division.
+func (sm *StepMode) Template() Template {
+	if sm == nil {
+		return nil
+	}
+
+	return Template(halftone.NewTemplate(sm.template...))
+}
+
+// Length returns amount of degrees in the mode.
+func (sm *StepMode) Length() int {
+	if sm == nil {
+		return 0
+	}
+
+	return len(sm.template)
+}
+
+// StepsFromPrime returns amount of steps from the first degree to each degree of the mode, starting with the first degree.
+func (sm *StepMode) StepsFromPrime() []halftone.HalfTones {
+	if sm == nil {
+		return nil
+	}
+
+	steps := make([]halftone.HalfTones, 0, sm.Length())
+	steps = append(steps, 0)
+	for iteratorResult := range sm.template.IterateOneRound(false) {
+		_, _, stepsFromPrime := iteratorResult()
+		steps = append(steps, stepsFromPrime)
+	}
+
+	return steps
+}
+
+// Intervals returns intervals from the first degree to each degree of the mode, starting with the unison.
+func (sm *StepMode) Intervals() []*interval.EDO {
+	stepsFromPrime := sm.StepsFromPrime()
+	intervals := make([]*interval.EDO, 0, len(stepsFromPrime))
+	for _, steps := range stepsFromPrime {
+		intervals = append(intervals, interval.MustNewEDO(sm.edo, steps))
+	}
+
+	return intervals
+}
+
+// Cents returns the distance in cents from the first degree to each degree of the mode, starting with the first degree.
+func (sm *StepMode) Cents() []float64 {
+	stepsFromPrime := sm.StepsFromPrime()
+	cents := make([]float64, 0, len(stepsFromPrime))
+	for _, steps := range stepsFromPrime {
+		cents = append(cents, sm.edo.Cents(int(steps)))
+	}
+
+	return cents
+}
+
+// Frequencies returns frequencies of the degrees of the mode built from the given frequency of the first degree.
+func (sm *StepMode) Frequencies(tonicFrequency float64) []float64 {
+	stepsFromPrime := sm.StepsFromPrime()
+	frequencies := make([]float64, 0, len(stepsFromPrime))
+	for _, steps := range stepsFromPrime {
+		frequencies = append(frequencies, sm.edo.Frequency(tonicFrequency, int(steps)))
+	}
+
+	return frequencies
+}
@@ -0,0 +1,23 @@
+package mode_test
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+)
+
+// Converting a twelve-tone mode to 31-EDO keeps its degrees but uses the meantone steps of the division.
+func ExampleMakeNewStepMode() {
+	harmonicMinor, err := mode.MakeNewStepMode(mode.NameHarmonicMinor, halftone.EDO31)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(harmonicMinor.Template())
+	for _, ie := range harmonicMinor.Intervals() {
+		fmt.Printf("%s ", ie)
+	}
+	// Output: [5 3 5 5 3 7 3]
+	// 0\31 5\31 8\31 13\31 18\31 21\31 28\31
+}
@@ -0,0 +1,65 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/interval"
+)
+
+func TestNewStepMode(t *testing.T) {
+	sm, err := NewStepMode("Rast", halftone.EDO24, Template{4, 3, 3, 4, 4, 3, 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, Name("Rast"), sm.Name())
+	assert.Equal(t, halftone.EDO24, sm.EDO())
+	assert.Equal(t, 7, sm.Length())
+	assert.Equal(t, []halftone.HalfTones{0, 4, 7, 10, 14, 18, 21}, sm.StepsFromPrime())
+	assert.Equal(t, []float64{0, 200, 350, 500, 700, 900, 1050}, sm.Cents())
+
+	shortNames := make([]interval.Name, 0, sm.Length())
+	for _, ie := range sm.Intervals() {
+		shortNames = append(shortNames, ie.ShortName())
+	}
+	assert.Equal(t, []interval.Name{"P1", "M2", "n3", "P4", "P5", "M6", "n7"}, shortNames)
+
+	// the template is copied
+	sm.Template()[0] = 1
+	assert.Equal(t, Template{4, 3, 3, 4, 4, 3, 3}, sm.Template())
+
+	_, err = NewStepMode("Rast", halftone.EDO19, Template{4, 3, 3, 4, 4, 3, 3})
+	require.ErrorIs(t, err, ErrInvalidModeTemplate)
+
+	_, err = NewStepMode("Rast", 0, Template{4, 3, 3, 4, 4, 3, 3})
+	require.ErrorIs(t, err, halftone.ErrInvalidEDO)
+}
+
+func TestMakeNewStepMode(t *testing.T) {
+	sm, err := MakeNewStepMode(NameIonian, halftone.EDO19)
+	require.NoError(t, err)
+	assert.Equal(t, Template{3, 3, 2, 3, 3, 3, 2}, sm.Template())
+
+	frequencies := sm.Frequencies(440)
+	require.Len(t, frequencies, 7)
+	assert.InDelta(t, 440.0, frequencies[0], 0.000001)
+	assert.InDelta(t, halftone.EDO19.Frequency(440, 11), frequencies[4], 0.000001)
+
+	_, err = MakeNewStepMode("unknown", halftone.EDO19)
+	require.ErrorIs(t, err, ErrNameUnknown)
+
+	_, err = MakeNewStepMode(NamePentatonicMajor, halftone.EDO19)
+	require.ErrorIs(t, err, ErrInvalidModeTemplate)
+}
+
+func TestStepModeNil(t *testing.T) {
+	var sm *StepMode
+	assert.Empty(t, sm.Name())
+	assert.Zero(t, sm.EDO())
+	assert.Nil(t, sm.Template())
+	assert.Zero(t, sm.Length())
+	assert.Nil(t, sm.StepsFromPrime())
+	assert.Empty(t, sm.Cents())
+}
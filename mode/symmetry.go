@@ -0,0 +1,154 @@
+package mode
+
+import (
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+// rotateMask transposes pitch classes of the mask by the given amount of halftones.
+func rotateMask(mask pitchClassMask, halfTones uint8) pitchClassMask {
+	const octave = uint8(halftone.HalfTonesInOctave)
+	halfTones %= octave
+	full := pitchClassMask(1)<<octave - 1
+
+	return (mask<<halfTones | mask>>(octave-halfTones)) & full
+}
+
+// invertMask inverts pitch classes of the mask around the given sum: each pitch class p becomes sum - p.
+func invertMask(mask pitchClassMask, sum uint8) pitchClassMask {
+	const octave = uint8(halftone.HalfTonesInOctave)
+	var inverted pitchClassMask
+	for pc := range octave {
+		if mask&(1<<pc) != 0 {
+			inverted |= 1 << ((sum + octave - pc) % octave)
+		}
+	}
+
+	return inverted
+}
+
+// TranspositionalSymmetry returns amounts of halftones transposing the template to the same pitch classes, starting from zero.
+// Most templates are transposed to themselves only by zero halftones, the whole tone scale is transposed by any whole tone: 0, 2, 4, 6, 8 and 10.
+// It returns nil for invalid templates.
+func (t Template) TranspositionalSymmetry() []halftone.HalfTones {
+	if t.Validate() != nil {
+		return nil
+	}
+
+	mask := maskOfTemplate(t, 0)
+	symmetry := make([]halftone.HalfTones, 0, 1)
+	for halfTones := range uint8(halftone.HalfTonesInOctave) {
+		if rotateMask(mask, halfTones) == mask {
+			symmetry = append(symmetry, halftone.HalfTones(halfTones))
+		}
+	}
+
+	return symmetry
+}
+
+// Transpositions returns amount of distinct transpositions of the template: 12 for most templates,
+// 2 for the whole tone scale, 3 for the diminished scales and 1 for the chromatic scale. It returns zero for invalid templates.
+func (t Template) Transpositions() int {
+	symmetry := t.TranspositionalSymmetry()
+	if len(symmetry) == 0 {
+		return 0
+	}
+
+	return int(halftone.HalfTonesInOctave) / len(symmetry)
+}
+
+// IsLimitedTransposition checks if the template is a mode of limited transposition:
+// it has less than twelve distinct transpositions, as Messiaen's modes do.
+func (t Template) IsLimitedTransposition() bool {
+	transpositions := t.Transpositions()
+
+	return transpositions > 0 && transpositions < int(halftone.HalfTonesInOctave)
+}
+
+// InversionalSymmetry returns sums of pitch classes inverting the template built from C to the same pitch classes:
+// each pitch class p is mapped to sum - p. E.g. C Dorian is inverted to itself around C, so it gives 0,
+// and the whole tone scale gives 0, 2, 4, 6, 8 and 10. Templates without inversional symmetry give an empty slice.
+// It returns nil for invalid templates.
+func (t Template) InversionalSymmetry() []halftone.HalfTones {
+	if t.Validate() != nil {
+		return nil
+	}
+
+	mask := maskOfTemplate(t, 0)
+	symmetry := make([]halftone.HalfTones, 0)
+	for sum := range uint8(halftone.HalfTonesInOctave) {
+		if invertMask(mask, sum) == mask {
+			symmetry = append(symmetry, halftone.HalfTones(sum))
+		}
+	}
+
+	return symmetry
+}
+
+// IsInversionallySymmetric checks if the template inverted around some pitch class gives the same pitch classes.
+func (t Template) IsInversionallySymmetric() bool {
+	return len(t.InversionalSymmetry()) > 0
+}
+
+// EquivalentTonics returns notes of the mode built from the tonic which as tonics give modes with the same pitch classes,
+// starting from the tonic itself. E.g. the whole tone scale built from C has the same pitch classes as the ones built from D, E, F#, Ab and Bb.
+// It returns nil for invalid templates.
+func (t Template) EquivalentTonics(tonic *note.Note) note.Notes {
+	symmetry := t.TranspositionalSymmetry()
+	if tonic == nil || len(symmetry) == 0 {
+		return nil
+	}
+
+	tonics := make(note.Notes, 0, len(symmetry))
+	m := newModeBuilder(t).build("", tonic.Copy())
+	for d := range m.IterateOneRound(false) {
+		for _, halfTones := range symmetry {
+			if d.HalfTonesFromPrime() == halfTones {
+				tonics = append(tonics, d.Note().Copy())
+			}
+		}
+	}
+
+	return tonics
+}
+
+// deduplicateByPitchClasses removes modes with the same names and pitch classes as other modes of the result
+// built from the tonics of limited transposition templates. The mode with the tonic going first among the given tonics is kept.
+func deduplicateByPitchClasses(result TemplatesWithPrime, tonics note.Notes) TemplatesWithPrime {
+	order := make(map[note.Name]int, len(tonics))
+	for i, tonic := range tonics {
+		if _, ok := order[tonic.Name()]; !ok {
+			order[tonic.Name()] = i
+		}
+	}
+
+	type key struct {
+		name Name
+		mask pitchClassMask
+	}
+
+	keyOf := func(twp TemplateWithPrime) key {
+		return key{twp.Name, maskOfTemplate(twp.ModeTemplate, pitchClassOf(twp.PrimeNote))}
+	}
+
+	kept := make(map[key]int, len(result))
+	for i, twp := range result {
+		if !twp.ModeTemplate.IsLimitedTransposition() {
+			continue
+		}
+
+		k := keyOf(twp)
+		if j, ok := kept[k]; !ok || order[twp.PrimeNote.Name()] < order[result[j].PrimeNote.Name()] {
+			kept[k] = i
+		}
+	}
+
+	deduplicated := make(TemplatesWithPrime, 0, len(result))
+	for i, twp := range result {
+		if !twp.ModeTemplate.IsLimitedTransposition() || kept[keyOf(twp)] == i {
+			deduplicated = append(deduplicated, twp)
+		}
+	}
+
+	return deduplicated
+}
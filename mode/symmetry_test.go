@@ -0,0 +1,110 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/note"
+)
+
+func TestTemplate_TranspositionalSymmetry(t *testing.T) {
+	testCases := []struct {
+		name           string
+		template       Template
+		symmetry       []halftone.HalfTones
+		transpositions int
+		limited        bool
+	}{
+		{"Ionian", TemplateIonian(), []halftone.HalfTones{0}, 12, false},
+		{"PentatonicMajor", TemplatePentatonicMajor(), []halftone.HalfTones{0}, 12, false},
+		{"WholeTone", TemplateWholeTone(), []halftone.HalfTones{0, 2, 4, 6, 8, 10}, 2, true},
+		{"Augmented", TemplateAugmented(), []halftone.HalfTones{0, 4, 8}, 4, true},
+		{"DiminishedHalfWhole", TemplateDiminishedHalfWhole(), []halftone.HalfTones{0, 3, 6, 9}, 3, true},
+		{"MessiaenMode3", TemplateMessiaenMode3(), []halftone.HalfTones{0, 4, 8}, 4, true},
+		{"MessiaenMode4", TemplateMessiaenMode4(), []halftone.HalfTones{0, 6}, 6, true},
+		{"MessiaenMode5", TemplateMessiaenMode5(), []halftone.HalfTones{0, 6}, 6, true},
+		{"MessiaenMode6", TemplateMessiaenMode6(), []halftone.HalfTones{0, 6}, 6, true},
+		{"MessiaenMode7", TemplateMessiaenMode7(), []halftone.HalfTones{0, 6}, 6, true},
+		{"chromatic", Template{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, []halftone.HalfTones{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, 1, true},
+		{"invalid", Template{2, 2}, nil, 0, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.symmetry, testCase.template.TranspositionalSymmetry())
+			assert.Equal(t, testCase.transpositions, testCase.template.Transpositions())
+			assert.Equal(t, testCase.limited, testCase.template.IsLimitedTransposition())
+		})
+	}
+}
+
+func TestTemplate_InversionalSymmetry(t *testing.T) {
+	testCases := []struct {
+		name     string
+		template Template
+		symmetry []halftone.HalfTones
+	}{
+		{"Ionian is symmetric around D", TemplateIonian(), []halftone.HalfTones{4}},
+		{"Dorian is symmetric around its tonic", TemplateDorian(), []halftone.HalfTones{0}},
+		{"HarmonicMinor", TemplateHarmonicMinor(), []halftone.HalfTones{}},
+		{"WholeTone", TemplateWholeTone(), []halftone.HalfTones{0, 2, 4, 6, 8, 10}},
+		{"DiminishedHalfWhole", TemplateDiminishedHalfWhole(), []halftone.HalfTones{1, 4, 7, 10}},
+		{"invalid", Template{2, 2}, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.symmetry, testCase.template.InversionalSymmetry())
+			assert.Equal(t, len(testCase.symmetry) > 0, testCase.template.IsInversionallySymmetric())
+		})
+	}
+}
+
+func TestTemplate_EquivalentTonics(t *testing.T) {
+	assert.Equal(t, []note.Name{note.C, note.D, note.E, note.FSHARP, note.AFLAT, note.BFLAT}, noteNames(TemplateWholeTone().EquivalentTonics(note.C.MustMakeNote())))
+	assert.Equal(t, []note.Name{note.C, note.EFLAT, note.FSHARP, note.A}, noteNames(TemplateDiminishedHalfWhole().EquivalentTonics(note.C.MustMakeNote())))
+	assert.Equal(t, []note.Name{note.D}, noteNames(TemplateDorian().EquivalentTonics(note.D.MustMakeNote())))
+
+	assert.Nil(t, TemplateDorian().EquivalentTonics(nil))
+	assert.Nil(t, Template{2, 2}.EquivalentTonics(note.C.MustMakeNote()))
+}
+
+func TestFindModeTemplatesByNotes_LimitedTransposition(t *testing.T) {
+	mts := InitTemplatesStore()
+	index := mts.NewPitchClassIndex()
+	notes := note.MustNewNotesFromNoteNames(note.E, note.C, note.D)
+
+	for _, result := range []TemplatesWithPrime{mts.FindModeTemplatesByNotes(notes), index.FindModeTemplatesByNotes(notes)} {
+		var wholeTone TemplatesWithPrime
+		for _, r := range result {
+			if r.Name == NameWholeTone {
+				wholeTone = append(wholeTone, r)
+			}
+		}
+
+		// C, D and E whole tone scales have the same pitch classes, the first given tonic is kept
+		require.Len(t, wholeTone, 1)
+		assert.Equal(t, note.E, wholeTone[0].PrimeNote.Name())
+
+		// Modes without limited transposition are found from each tonic
+		var mixoLydian int
+		for _, r := range result {
+			if r.Name == NameMixoLydian {
+				mixoLydian++
+			}
+		}
+		assert.Equal(t, 2, mixoLydian)
+	}
+}
+
+func noteNames(ns note.Notes) []note.Name {
+	names := make([]note.Name, 0, len(ns))
+	for _, n := range ns {
+		names = append(names, n.Name())
+	}
+
+	return names
+}
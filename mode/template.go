@@ -74,26 +74,92 @@ var ErrInvalidModeTemplate = errors.New("invalid mode template")
 
 // Validate checks mode template for length, halftone sum in octave, zero intervals.
 func (t Template) Validate() error {
+	return t.ValidateEDO(halftone.EDO12)
+}
+
+// ValidateEDO checks mode template for length, sum of steps in octave of the given division, zero intervals.
+// Values of the template are considered as steps of the division.
+func (t Template) ValidateEDO(edo halftone.EDO) error {
 	if t.Length() < 1 {
 		return fmt.Errorf("zero length: %w", ErrInvalidModeTemplate)
 	}
 
-	var halfTones halftone.HalfTones
+	var steps int
 	for _, interval := range t {
 		if interval == 0 {
 			return fmt.Errorf("zero interval: %w", ErrInvalidModeTemplate)
 		}
 
-		halfTones += interval
+		steps += int(interval)
 	}
 
-	if halfTones != halftone.HalfTonesInOctave {
-		return fmt.Errorf("halftones overflows octave: %w", ErrInvalidModeTemplate)
+	if steps != int(edo.StepsInOctave()) {
+		return fmt.Errorf("steps overflows octave of %d-EDO: %w", edo, ErrInvalidModeTemplate)
 	}
 
 	return nil
 }
 
+// ToEDO converts the twelve-tone mode template to the given equal division of the octave.
+// In divisions multiple of 12 each halftone is just split into equal steps.
+// Otherwise, the template must be heptatonic: each degree is considered as an alteration of the corresponding
+// degree of the major scale, natural degrees are built by the whole tones and diatonic semitones of the division,
+// and each alteration shifts the degree by its chromatic semitone.
+func (t Template) ToEDO(edo halftone.EDO) (Template, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := edo.Validate(); err != nil {
+		return nil, fmt.Errorf("convert template to %d-EDO: %w", edo, err)
+	}
+
+	if edo.IsMultipleOf12() {
+		multiplier := halftone.HalfTones(edo / halftone.EDO12)
+		converted := make(Template, len(t))
+		for i, halfTones := range t {
+			converted[i] = halfTones * multiplier
+		}
+
+		return converted, nil
+	}
+
+	if !t.IsHeptatonic() {
+		return nil, fmt.Errorf("only heptatonic templates can be converted to %d-EDO: %w", edo, ErrInvalidModeTemplate)
+	}
+
+	major := TemplateIonian()
+	wholeTone, diatonicSemitone, chromaticSemitone := int(edo.WholeTone()), int(edo.DiatonicSemitone()), int(edo.ChromaticSemitone())
+
+	converted := make(Template, 0, len(t))
+	var halfTonesFromPrime, majorHalfTonesFromPrime, stepsFromPrime, previousSteps int
+	for i, halfTones := range t {
+		halfTonesFromPrime += int(halfTones)
+		majorHalfTonesFromPrime += int(major[i])
+
+		// Natural degree of the major scale plus alteration by chromatic semitones
+		if major[i] == halftone.HalfTones(1) {
+			stepsFromPrime += diatonicSemitone
+		} else {
+			stepsFromPrime += wholeTone
+		}
+
+		steps := stepsFromPrime + (halfTonesFromPrime-majorHalfTonesFromPrime)*chromaticSemitone
+		if steps <= previousSteps {
+			return nil, fmt.Errorf("degree %d collapses in %d-EDO: %w", i+2, edo, ErrInvalidModeTemplate) //nolint:mnd
+		}
+
+		converted = append(converted, halftone.HalfTones(steps-previousSteps)) //nolint:gosec // steps are within the octave
+		previousSteps = steps
+	}
+
+	if err := converted.ValidateEDO(edo); err != nil {
+		return nil, fmt.Errorf("convert template to %d-EDO: %w", edo, err)
+	}
+
+	return converted, nil
+}
+
 // IsDiatonic checks if the mode is diatonic or not.
 // Strictly speaking, diatonic scales are characterized by the ability to be decomposed into fifths.
 // In this case, we mean that diatonic modes have seven degrees and do not have augmented seconds.
@@ -126,3 +192,45 @@ func (t Template) RearrangeFromDegree(degree degree.Number) Template {
 
 	return ltArranged
 }
+
+// IsEqual checks if the templates have the same steps.
+func (t Template) IsEqual(other Template) bool {
+	if len(t) != len(other) {
+		return false
+	}
+
+	for i := range t {
+		if t[i] != other[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rotations returns all the templates rebuilt from each degree of the template, starting from the first one.
+// The rotations are the modes of the same scale, e.g. seven modes of the major scale.
+func (t Template) Rotations() []Template {
+	rotations := make([]Template, 0, t.Length())
+	for degreeNum := degree.Number(1); degreeNum <= t.Length(); degreeNum++ {
+		rotations = append(rotations, t.RearrangeFromDegree(degreeNum))
+	}
+
+	return rotations
+}
+
+// RotationOf returns the number of the degree of the other template the template is rebuilt from.
+// It returns false if the template is not a rotation of the other one.
+func (t Template) RotationOf(other Template) (degree.Number, bool) {
+	if len(t) != len(other) {
+		return 0, false
+	}
+
+	for i, rotation := range other.Rotations() {
+		if rotation.IsEqual(t) {
+			return degree.Number(i + 1), true //nolint:gosec // amount of degrees is small
+		}
+	}
+
+	return 0, false
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/go-muse/muse/degree"
 	"github.com/go-muse/muse/halftone"
@@ -229,3 +230,53 @@ func TestModeTemplateIterateOneRound(t *testing.T) {
 	_, isOpen = <-c
 	assert.False(t, isOpen)
 }
+
+func TestValidateEDO(t *testing.T) {
+	assert.NoError(t, TemplateIonian().ValidateEDO(halftone.EDO12))
+	assert.NoError(t, Template{3, 3, 2, 3, 3, 3, 2}.ValidateEDO(halftone.EDO19))
+	assert.ErrorIs(t, TemplateIonian().ValidateEDO(halftone.EDO19), ErrInvalidModeTemplate)
+	assert.ErrorIs(t, Template{}.ValidateEDO(halftone.EDO24), ErrInvalidModeTemplate)
+	assert.ErrorIs(t, Template{24, 0}.ValidateEDO(halftone.EDO24), ErrInvalidModeTemplate)
+}
+
+func TestTemplateToEDO(t *testing.T) {
+	testCases := []struct {
+		name         string
+		modeTemplate Template
+		edo          halftone.EDO
+		expected     Template
+	}{
+		{name: "Ionian in 12-EDO", modeTemplate: TemplateIonian(), edo: halftone.EDO12, expected: TemplateIonian()},
+		{name: "Ionian in 19-EDO", modeTemplate: TemplateIonian(), edo: halftone.EDO19, expected: Template{3, 3, 2, 3, 3, 3, 2}},
+		{name: "Ionian in 31-EDO", modeTemplate: TemplateIonian(), edo: halftone.EDO31, expected: Template{5, 5, 3, 5, 5, 5, 3}},
+		{name: "Ionian in 53-EDO", modeTemplate: TemplateIonian(), edo: halftone.EDO53, expected: Template{9, 9, 4, 9, 9, 9, 4}},
+		{name: "Harmonic minor in 19-EDO", modeTemplate: TemplateHarmonicMinor(), edo: halftone.EDO19, expected: Template{3, 2, 3, 3, 2, 4, 2}},
+		{name: "Harmonic minor in 31-EDO", modeTemplate: TemplateHarmonicMinor(), edo: halftone.EDO31, expected: Template{5, 3, 5, 5, 3, 7, 3}},
+		{name: "Harmonic minor in 24-EDO", modeTemplate: TemplateHarmonicMinor(), edo: halftone.EDO24, expected: Template{4, 2, 4, 4, 2, 6, 2}},
+		{name: "Pentatonic in 24-EDO", modeTemplate: TemplatePentatonicMajor(), edo: halftone.EDO24, expected: Template{4, 4, 6, 4, 6}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converted, err := testCase.modeTemplate.ToEDO(testCase.edo)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, converted)
+			assert.NoError(t, converted.ValidateEDO(testCase.edo))
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := TemplatePentatonicMajor().ToEDO(halftone.EDO19)
+		require.ErrorIs(t, err, ErrInvalidModeTemplate)
+
+		// There is no diatonic semitone in 5-EDO
+		_, err = TemplateIonian().ToEDO(halftone.EDO(5))
+		require.ErrorIs(t, err, ErrInvalidModeTemplate)
+
+		_, err = TemplateIonian().ToEDO(0)
+		require.ErrorIs(t, err, halftone.ErrInvalidEDO)
+
+		_, err = Template{1, 2}.ToEDO(halftone.EDO19)
+		require.ErrorIs(t, err, ErrInvalidModeTemplate)
+	})
+}
@@ -128,6 +128,50 @@ func GetTemplateByName(modeName Name) (Template, error) {
 		return TemplatePentatonicHonKumoiJoshi(), nil
 	case NamePentatonicLydianPentatonic:
 		return TemplatePentatonicLydianPentatonic(), nil
+
+	// Hexatonic modes
+
+	case NameWholeTone:
+		return TemplateWholeTone(), nil
+	case NameAugmented:
+		return TemplateAugmented(), nil
+	case NameBluesHexatonic:
+		return TemplateBluesHexatonic(), nil
+
+	// Octatonic modes
+
+	case NameDiminishedHalfWhole:
+		return TemplateDiminishedHalfWhole(), nil
+	case NameDiminishedWholeHalf:
+		return TemplateDiminishedWholeHalf(), nil
+
+	// Bebop modes
+
+	case NameBebopDominant:
+		return TemplateBebopDominant(), nil
+	case NameBebopMajor:
+		return TemplateBebopMajor(), nil
+	case NameBebopDorian:
+		return TemplateBebopDorian(), nil
+	case NameBebopMelodicMinor:
+		return TemplateBebopMelodicMinor(), nil
+
+	// Messiaen's modes of limited transposition
+
+	case NameMessiaenMode1:
+		return TemplateMessiaenMode1(), nil
+	case NameMessiaenMode2:
+		return TemplateMessiaenMode2(), nil
+	case NameMessiaenMode3:
+		return TemplateMessiaenMode3(), nil
+	case NameMessiaenMode4:
+		return TemplateMessiaenMode4(), nil
+	case NameMessiaenMode5:
+		return TemplateMessiaenMode5(), nil
+	case NameMessiaenMode6:
+		return TemplateMessiaenMode6(), nil
+	case NameMessiaenMode7:
+		return TemplateMessiaenMode7(), nil
 	}
 
 	return nil, fmt.Errorf("got: '%s: %w", modeName, ErrNameUnknown)
@@ -355,4 +399,76 @@ func TemplatePentatonicLydianPentatonic() Template {
 	return Template{4, 2, 1, 4, 1}
 }
 
+// Hexatonic modes
+
+func TemplateWholeTone() Template {
+	return Template{2, 2, 2, 2, 2, 2}
+}
+
+func TemplateAugmented() Template {
+	return Template{3, 1, 3, 1, 3, 1}
+}
+
+func TemplateBluesHexatonic() Template {
+	return Template{3, 2, 1, 1, 3, 2}
+}
+
+// Octatonic modes
+
+func TemplateDiminishedHalfWhole() Template {
+	return Template{1, 2, 1, 2, 1, 2, 1, 2}
+}
+
+func TemplateDiminishedWholeHalf() Template {
+	return Template{2, 1, 2, 1, 2, 1, 2, 1}
+}
+
+// Bebop modes
+
+func TemplateBebopDominant() Template {
+	return Template{2, 2, 1, 2, 2, 1, 1, 1}
+}
+
+func TemplateBebopMajor() Template {
+	return Template{2, 2, 1, 2, 1, 1, 2, 1}
+}
+
+func TemplateBebopDorian() Template {
+	return Template{2, 1, 1, 1, 2, 2, 1, 2}
+}
+
+func TemplateBebopMelodicMinor() Template {
+	return Template{2, 1, 2, 2, 1, 1, 2, 1}
+}
+
+// Messiaen's modes of limited transposition
+
+func TemplateMessiaenMode1() Template {
+	return TemplateWholeTone()
+}
+
+func TemplateMessiaenMode2() Template {
+	return TemplateDiminishedHalfWhole()
+}
+
+func TemplateMessiaenMode3() Template {
+	return Template{2, 1, 1, 2, 1, 1, 2, 1, 1}
+}
+
+func TemplateMessiaenMode4() Template {
+	return Template{1, 1, 3, 1, 1, 1, 3, 1}
+}
+
+func TemplateMessiaenMode5() Template {
+	return Template{1, 4, 1, 1, 4, 1}
+}
+
+func TemplateMessiaenMode6() Template {
+	return Template{2, 2, 1, 1, 2, 2, 1, 1}
+}
+
+func TemplateMessiaenMode7() Template {
+	return Template{1, 1, 1, 2, 1, 1, 1, 1, 2, 1}
+}
+
 // others
@@ -1,8 +1,10 @@
 package mode
 
 import (
+	"slices"
 	"sort"
 
+	"github.com/go-muse/muse/degree"
 	"github.com/go-muse/muse/note"
 )
 
@@ -77,6 +79,37 @@ func InitTemplatesStore() TemplatesStore {
 	ts[NamePentatonicBluesMajor] = TemplatePentatonicBluesMajor()
 	ts[NamePentatonicMinor] = TemplatePentatonicMinor()
 
+	// Japanese pentatonic modes
+	ts[NamePentatonicHirajoshi] = TemplatePentatonicHirajoshi()
+	ts[NamePentatonicIwato] = TemplatePentatonicIwato()
+	ts[NamePentatonicHonKumoiShiouzhi] = TemplatePentatonicHonKumoiShiouzhi()
+	ts[NamePentatonicHonKumoiJoshi] = TemplatePentatonicHonKumoiJoshi()
+	ts[NamePentatonicLydianPentatonic] = TemplatePentatonicLydianPentatonic()
+
+	// Hexatonic modes
+	ts[NameWholeTone] = TemplateWholeTone()
+	ts[NameAugmented] = TemplateAugmented()
+	ts[NameBluesHexatonic] = TemplateBluesHexatonic()
+
+	// Octatonic modes
+	ts[NameDiminishedHalfWhole] = TemplateDiminishedHalfWhole()
+	ts[NameDiminishedWholeHalf] = TemplateDiminishedWholeHalf()
+
+	// Bebop modes
+	ts[NameBebopDominant] = TemplateBebopDominant()
+	ts[NameBebopMajor] = TemplateBebopMajor()
+	ts[NameBebopDorian] = TemplateBebopDorian()
+	ts[NameBebopMelodicMinor] = TemplateBebopMelodicMinor()
+
+	// Messiaen's modes of limited transposition
+	ts[NameMessiaenMode1] = TemplateMessiaenMode1()
+	ts[NameMessiaenMode2] = TemplateMessiaenMode2()
+	ts[NameMessiaenMode3] = TemplateMessiaenMode3()
+	ts[NameMessiaenMode4] = TemplateMessiaenMode4()
+	ts[NameMessiaenMode5] = TemplateMessiaenMode5()
+	ts[NameMessiaenMode6] = TemplateMessiaenMode6()
+	ts[NameMessiaenMode7] = TemplateMessiaenMode7()
+
 	return ts
 }
 
@@ -110,6 +143,19 @@ func (ts TemplatesStore) FindModeTemplatesByPattern(modeTemplate Template) Templ
 	return result
 }
 
+// FindModeTemplatesByDegrees returns mode templates with one of the given amounts of degrees,
+// e.g. DegreesInPentatonic gives all the pentatonic modes of the store.
+func (ts TemplatesStore) FindModeTemplatesByDegrees(degrees ...degree.Number) TemplatesStore {
+	result := make(TemplatesStore)
+	for modeName, modeTemplate := range ts {
+		if slices.Contains(degrees, modeTemplate.Length()) {
+			result[modeName] = modeTemplate
+		}
+	}
+
+	return result
+}
+
 func (ts TemplatesStore) Contains(modeName Name) bool {
 	if _, ok := ts[modeName]; ok {
 		return true
@@ -197,16 +243,19 @@ func (tswp TemplatesWithPrime) Contains(modeName Name) bool {
 
 // FindModeTemplatesByNotes searches for modes in the storage
 // that correspond to the given set of notes and returns a new storage with the found modes.
+// Modes of limited transposition built from different tonics with the same pitch classes are listed once,
+// with the tonic going first among the given notes.
 func (ts TemplatesStore) FindModeTemplatesByNotes(ns note.Notes) TemplatesWithPrime {
 	result := make(TemplatesWithPrime, 0)
 	var mode *Mode
-	for modeName, modeTemplate := range ts {
-		notesWithAlterations := note.GetNotesWithAlterations(ns, 0)
-		allNotes := make(note.Notes, len(ns)+len(notesWithAlterations))
-		copy(allNotes, ns)
-		allNotes = append(allNotes, notesWithAlterations...)
+	notesWithAlterations := note.GetNotesWithAlterations(ns, 0)
+	allNotes := make(note.Notes, len(ns), len(ns)+len(notesWithAlterations))
+	copy(allNotes, ns)
+	allNotes = append(allNotes, notesWithAlterations...)
+	tonics := allNotes.Uniques()
 
-		for _, firstNote := range allNotes.Uniques() {
+	for modeName, modeTemplate := range ts {
+		for _, firstNote := range tonics {
 			mode = MustMakeNewMode(modeName, firstNote.Name())
 			for _, note := range ns {
 				if !mode.Contains(note) {
@@ -225,7 +274,7 @@ func (ts TemplatesStore) FindModeTemplatesByNotes(ns note.Notes) TemplatesWithPr
 		}
 	}
 
-	return result
+	return deduplicateByPitchClasses(result, tonics)
 }
 
 // SortByName sorts the slice with mode names and templates with prime notes by mode name.
@@ -2,6 +2,8 @@ package mode_test
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/go-muse/muse/mode"
 	"github.com/go-muse/muse/note"
@@ -19,6 +21,26 @@ func ExampleInitTemplatesStore() {
 	// Output: [2 1 2 2 1 2 2]
 }
 
+// The store contains modes with different amounts of degrees, and they can be filtered by it.
+// Modes with any amount of degrees are spelled by intervals from the tonic.
+func ExampleTemplatesStore_FindModeTemplatesByDegrees() {
+	mts := mode.InitTemplatesStore()
+
+	for _, nat := range mts.FindModeTemplatesByDegrees(mode.DegreesInOctatonic).AsSlice().SortByName(false) {
+		fmt.Println(nat.Name, mode.MustMakeNewMode(nat.Name, note.C).GenerateScale(false))
+	}
+	// Output:
+	// BebopDominant [C D E F G A Bb B]
+	// BebopDorian [C D Eb E F G A Bb]
+	// BebopMajor [C D E F G Ab A B]
+	// BebopMelodicMinor [C D Eb F G Ab A B]
+	// DiminishedHalfWhole [C Db Eb E F# G A Bb]
+	// DiminishedWholeHalf [C D Eb F Gb Ab A B]
+	// MessiaenMode2 [C Db Eb E F# G A Bb]
+	// MessiaenMode4 [C Db D F F# G Ab B]
+	// MessiaenMode6 [C D E F Gb Ab Bb B]
+}
+
 // You can get the mode templates store as slice and sort it by mode names.
 // Also, you can specify sorting order.
 func ExampleNamesAndTemplates_SortByName() {
@@ -33,6 +55,14 @@ func ExampleNamesAndTemplates_SortByName() {
 	// Output: Aeolian [2 1 2 2 1 2 2]
 	// AeolianLydian [2 1 2 1 2 2 2]
 	// AeolianRais7 [2 1 2 2 1 3 1]
+	// Augmented [3 1 3 1 3 1]
+	// BebopDominant [2 2 1 2 2 1 1 1]
+	// BebopDorian [2 1 1 1 2 2 1 2]
+	// BebopMajor [2 2 1 2 1 1 2 1]
+	// BebopMelodicMinor [2 1 2 2 1 1 2 1]
+	// BluesHexatonic [3 2 1 1 3 2]
+	// DiminishedHalfWhole [1 2 1 2 1 2 1 2]
+	// DiminishedWholeHalf [2 1 2 1 2 1 2 1]
 	// Dorian [2 1 2 2 2 1 2]
 	// DorianDiminished [2 1 2 1 3 1 2]
 	// HarmonicMajor [2 2 1 2 1 3 1]
@@ -58,6 +88,13 @@ func ExampleNamesAndTemplates_SortByName() {
 	// LydianRais9 [3 1 2 1 2 2 1]
 	// MelodicMajor [2 2 1 2 1 2 2]
 	// MelodicMinor [2 1 2 2 2 2 1]
+	// MessiaenMode1 [2 2 2 2 2 2]
+	// MessiaenMode2 [1 2 1 2 1 2 1 2]
+	// MessiaenMode3 [2 1 1 2 1 1 2 1 1]
+	// MessiaenMode4 [1 1 3 1 1 1 3 1]
+	// MessiaenMode5 [1 4 1 1 4 1]
+	// MessiaenMode6 [2 2 1 1 2 2 1 1]
+	// MessiaenMode7 [1 1 1 2 1 1 1 1 2 1]
 	// MixoLydian [2 2 1 2 2 1 2]
 	// MixolydianFlat2 [1 3 1 2 2 1 2]
 	// NaturalMajor [2 2 1 2 2 2 1]
@@ -65,6 +102,11 @@ func ExampleNamesAndTemplates_SortByName() {
 	// Oriental [1 3 1 1 3 1 2]
 	// PentatonicBluesMajor [2 3 2 2 3]
 	// PentatonicBluesMinor [3 2 3 2 2]
+	// PentatonicHirajoshi [2 1 4 1 4]
+	// PentatonicHonKumoiJoshi [1 4 2 1 4]
+	// PentatonicHonKumoiShiouzhi [4 1 4 2 1]
+	// PentatonicIwato [1 4 1 4 2]
+	// PentatonicLydianPentatonic [4 2 1 4 1]
 	// PentatonicMajor [2 2 3 2 3]
 	// PentatonicMinor [3 2 2 3 2]
 	// PentatonicSustained [2 3 2 3 2]
@@ -76,6 +118,7 @@ func ExampleNamesAndTemplates_SortByName() {
 	// UkrainianDorian [2 1 3 1 2 1 2]
 	// UltraLocrian [1 2 1 2 2 1 3]
 	// UltraPhrygian [1 2 1 3 1 1 3]
+	// WholeTone [2 2 2 2 2 2]
 }
 
 // You can get the mode templates store as slice and sort it by mode Templates.
@@ -89,7 +132,11 @@ func ExampleNamesAndTemplates_SortByTemplate() {
 	for _, info := range slc {
 		fmt.Println(info.ModeTemplate, info.Name)
 	}
-	// Output: [1 1 3 1 2 1 3] LocrianDoubleFlat3DoubleFlat7
+	// Output: [1 1 1 2 1 1 1 1 2 1] MessiaenMode7
+	// [1 1 3 1 1 1 3 1] MessiaenMode4
+	// [1 1 3 1 2 1 3] LocrianDoubleFlat3DoubleFlat7
+	// [1 2 1 2 1 2 1 2] DiminishedHalfWhole
+	// [1 2 1 2 1 2 1 2] MessiaenMode2
 	// [1 2 1 2 2 1 3] UltraLocrian
 	// [1 2 1 2 2 2 2] SuperLocrian
 	// [1 2 1 3 1 1 3] UltraPhrygian
@@ -103,8 +150,15 @@ func ExampleNamesAndTemplates_SortByTemplate() {
 	// [1 3 1 2 1 2 2] PhrygianDominant
 	// [1 3 1 2 1 3 1] HungarianMajor
 	// [1 3 1 2 2 1 2] MixolydianFlat2
+	// [1 4 1 1 4 1] MessiaenMode5
+	// [1 4 1 4 2] PentatonicIwato
+	// [1 4 2 1 4] PentatonicHonKumoiJoshi
+	// [2 1 1 1 2 2 1 2] BebopDorian
+	// [2 1 1 2 1 1 2 1 1] MessiaenMode3
+	// [2 1 2 1 2 1 2 1] DiminishedWholeHalf
 	// [2 1 2 1 2 2 2] AeolianLydian
 	// [2 1 2 1 3 1 2] DorianDiminished
+	// [2 1 2 2 1 1 2 1] BebopMelodicMinor
 	// [2 1 2 2 1 2 2] Aeolian
 	// [2 1 2 2 1 2 2] NaturalMinor
 	// [2 1 2 2 1 3 1] AeolianRais7
@@ -115,10 +169,14 @@ func ExampleNamesAndTemplates_SortByTemplate() {
 	// [2 1 3 1 1 3 1] HungarianMinor
 	// [2 1 3 1 2 1 2] UkrainianDorian
 	// [2 1 3 1 2 2 1] LydianDiminished
+	// [2 1 4 1 4] PentatonicHirajoshi
+	// [2 2 1 1 2 2 1 1] MessiaenMode6
+	// [2 2 1 2 1 1 2 1] BebopMajor
 	// [2 2 1 2 1 2 2] IonianAeolian
 	// [2 2 1 2 1 2 2] MelodicMajor
 	// [2 2 1 2 1 3 1] HarmonicMajor
 	// [2 2 1 2 1 3 1] IonianFlat6
+	// [2 2 1 2 2 1 1 1] BebopDominant
 	// [2 2 1 2 2 1 2] MixoLydian
 	// [2 2 1 2 2 2 1] Ionian
 	// [2 2 1 2 2 2 1] NaturalMajor
@@ -126,6 +184,8 @@ func ExampleNamesAndTemplates_SortByTemplate() {
 	// [2 2 2 1 2 1 2] LydianDominant
 	// [2 2 2 1 2 2 1] Lydian
 	// [2 2 2 2 1 2 1] LydianAugmented
+	// [2 2 2 2 2 2] MessiaenMode1
+	// [2 2 2 2 2 2] WholeTone
 	// [2 2 3 2 3] PentatonicMajor
 	// [2 3 2 2 3] PentatonicBluesMajor
 	// [2 3 2 3 2] PentatonicSustained
@@ -133,8 +193,12 @@ func ExampleNamesAndTemplates_SortByTemplate() {
 	// [3 1 2 1 2 2 1] LydianRais9
 	// [3 1 2 1 3 1 1] LydianRais2Rais6
 	// [3 1 2 2 1 2 1] LydianAugmented2
+	// [3 1 3 1 3 1] Augmented
+	// [3 2 1 1 3 2] BluesHexatonic
 	// [3 2 2 3 2] PentatonicMinor
 	// [3 2 3 2 2] PentatonicBluesMinor
+	// [4 1 4 2 1] PentatonicHonKumoiShiouzhi
+	// [4 2 1 4 1] PentatonicLydianPentatonic
 }
 
 // It's possible to find mode templates that match a given pattern.
@@ -187,10 +251,183 @@ func ExampleTemplatesStore_FindModeTemplatesByNotes() {
 	// Output: mode name: Aeolian, mode template: [2 1 2 2 1 2 2], prime note: A, scale: [A B C D E F G]
 	// mode name: NaturalMinor, mode template: [2 1 2 2 1 2 2], prime note: A, scale: [A B C D E F G]
 	// mode name: Locrian, mode template: [1 2 2 1 2 2 2], prime note: B, scale: [B C D E F G A]
+	// mode name: BebopDominant, mode template: [2 2 1 2 2 1 1 1], prime note: C, scale: [C D E F G A Bb B]
+	// mode name: BebopMajor, mode template: [2 2 1 2 1 1 2 1], prime note: C, scale: [C D E F G Ab A B]
 	// mode name: Ionian, mode template: [2 2 1 2 2 2 1], prime note: C, scale: [C D E F G A B]
 	// mode name: NaturalMajor, mode template: [2 2 1 2 2 2 1], prime note: C, scale: [C D E F G A B]
+	// mode name: BebopDorian, mode template: [2 1 1 1 2 2 1 2], prime note: D, scale: [D E F F# G A B C]
 	// mode name: Dorian, mode template: [2 1 2 2 2 1 2], prime note: D, scale: [D E F G A B C]
 	// mode name: Phrygian, mode template: [1 2 2 2 1 2 2], prime note: E, scale: [E F G A B C D]
 	// mode name: Lydian, mode template: [2 2 2 1 2 2 1], prime note: F, scale: [F G A B C D E]
+	// mode name: BebopDominant, mode template: [2 2 1 2 2 1 1 1], prime note: G, scale: [G A B C D E F F#]
+	// mode name: BebopDorian, mode template: [2 1 1 1 2 2 1 2], prime note: G, scale: [G A Bb B C D E F]
 	// mode name: MixoLydian, mode template: [2 2 1 2 2 1 2], prime note: G, scale: [G A B C D E F]
 }
+
+// The pitch-class index of the store finds the same modes as FindModeTemplatesByNotes does, but much faster,
+// so it fits to be called many times, e.g. for every bar of a piece.
+func ExampleTemplatesStore_NewPitchClassIndex() {
+	index := mode.InitTemplatesStore().NewPitchClassIndex()
+
+	notes := note.MustNewNotesFromNoteNames(note.E, note.FSHARP, note.GSHARP, note.A, note.B, note.CSHARP, note.D)
+	for _, r := range index.FindModeTemplatesByNotes(notes) {
+		fmt.Println(r.Name, r.PrimeNote.Name())
+	}
+	// Output:
+	// Aeolian F#
+	// BebopDominant E
+	// BebopDominant A
+	// BebopDorian E
+	// BebopDorian B
+	// BebopMajor A
+	// Dorian B
+	// Ionian A
+	// Locrian G#
+	// Lydian D
+	// MixoLydian E
+	// NaturalMajor A
+	// NaturalMinor F#
+	// Phrygian C#
+}
+
+// The fuzzy search finds modes of melodies with chromatic passing tones and ranks them from the best match.
+func ExampleTemplatesStore_FindModeTemplatesByNotesFuzzy() {
+	mts := mode.InitTemplatesStore()
+
+	melody := note.MustNewNotesFromNoteNames(note.G, note.A, note.B, note.C, note.D, note.C, note.CSHARP, note.D, note.E, note.FSHARP, note.G)
+	opts := mode.FuzzyOptions{MaxExtra: 1, MaxMissing: 1, Weighting: mode.WeightingByOccurrence}
+
+	for _, match := range mts.FindModeTemplatesByNotesFuzzy(melody, opts)[:4] {
+		fmt.Printf("%s %s %.2f missing: %v extra: %v\n", match.PrimeNote.Name(), match.Name, match.Score, match.Missing, match.Extra)
+	}
+	// Output:
+	// D BebopDominant 1.00 missing: [] extra: []
+	// A BebopDorian 1.00 missing: [] extra: []
+	// E Aeolian 0.91 missing: [] extra: [C#]
+	// A Dorian 0.91 missing: [] extra: [C#]
+}
+
+// Rotations of a template are named by the modes of the store, and the modes of the store are grouped into families.
+func ExampleTemplatesStore_Rotations() {
+	mts := mode.InitTemplatesStore()
+
+	for _, rotation := range mts.Rotations(mode.TemplateMelodicMinor()) {
+		fmt.Println(rotation.Degree, rotation.Template, rotation.Names)
+	}
+
+	family, err := mts.FamilyOf(mode.NameLydianDominant)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(family, len(mts.Families()[family]))
+	// Output:
+	// 1 [2 1 2 2 2 2 1] [IonianFlat3 MelodicMinor]
+	// 2 [1 2 2 2 2 1 2] [PhrygoDorian]
+	// 3 [2 2 2 2 1 2 1] [LydianAugmented]
+	// 4 [2 2 2 1 2 1 2] [LydianDominant]
+	// 5 [2 2 1 2 1 2 2] [IonianAeolian MelodicMajor]
+	// 6 [2 1 2 1 2 2 2] [AeolianLydian]
+	// 7 [1 2 1 2 2 2 2] [SuperLocrian]
+	// MelodicMinor 9
+}
+
+// Modes can be ordered by brightness, and the neighbouring modes are reachable by altering one degree.
+func ExampleTemplatesStore_Alterations() {
+	mts := mode.InitTemplatesStore()
+
+	majorModes, err := mode.GetFamilyByName(mode.FamilyMajor)
+	if err != nil {
+		panic(err)
+	}
+
+	nat := make(mode.NamesAndTemplates, 0, len(majorModes))
+	for _, name := range majorModes {
+		nat = append(nat, mode.NameAndTemplate{Name: name, ModeTemplate: mts[name]})
+	}
+
+	for _, m := range nat.SortByBrightness(true) {
+		brightness, err := m.ModeTemplate.Brightness()
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Print(m.Name, " ", brightness, ";")
+	}
+	fmt.Println()
+
+	for _, alteration := range mts.Alterations(mode.TemplateIonian()) {
+		if len(alteration.Names) > 0 {
+			fmt.Println(alteration, alteration.Characteristic, alteration.Names)
+		}
+	}
+	// Output:
+	// Lydian 39;Ionian 38;MixoLydian 37;Dorian 36;Aeolian 35;Phrygian 34;Locrian 33;
+	// b3 Minor [IonianFlat3 MelodicMinor]
+	// #4 Aug [Lydian]
+	// #5 Aug [IonianRais5]
+	// b6 Minor [HarmonicMajor IonianFlat6]
+	// b7 Minor [MixoLydian]
+}
+
+// All the templates can be enumerated to explore unnamed scales, rotations of the same template are sent once.
+func ExampleTemplatesStore_EnumerateTemplates() {
+	mts := mode.InitTemplatesStore()
+
+	var amount, named int
+	for et := range mts.EnumerateTemplates(7, 3) {
+		amount++
+		if et.IsNamed() {
+			named++
+		}
+	}
+	fmt.Println(amount, named)
+
+	for et := range mts.EnumerateTemplates(7, 2) {
+		fmt.Println(et.Template, et.Names)
+	}
+	// Output:
+	// 38 5
+	// [1 1 2 2 2 2 2] []
+	// [1 2 1 2 2 2 2] [AeolianLydian IonianAeolian IonianFlat3 LydianAugmented LydianDominant MelodicMajor MelodicMinor PhrygoDorian SuperLocrian]
+	// [1 2 2 1 2 2 2] [Aeolian Dorian Ionian Locrian Lydian MixoLydian NaturalMajor NaturalMinor Phrygian]
+}
+
+// Custom templates can be loaded from a YAML or JSON library and merged with the built-in store.
+func ExampleLoadLibraryYAML() {
+	library, err := mode.LoadLibraryYAML(strings.NewReader(`templates:
+  - name: Prometheus
+    aliases: [MysticChord]
+    steps: [2, 2, 2, 3, 1, 2]
+    origin: Scriabin
+    tags: [hexatonic]
+`))
+	if err != nil {
+		panic(err)
+	}
+
+	mts := mode.InitTemplatesStore()
+	if err := mts.Merge(library.Store(), false); err != nil {
+		panic(err)
+	}
+
+	mysticChord, err := mode.MakeNewCustomMode(mts["MysticChord"], "C", "MysticChord")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mysticChord.GenerateScale(false))
+
+	custom := &mode.Library{Templates: library.FilterByTag("hexatonic")}
+	if err := custom.WriteYAML(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [C D E F# A Bb]
+	// templates:
+	//   - name: Prometheus
+	//     aliases:
+	//       - MysticChord
+	//     steps: [2, 2, 2, 3, 1, 2]
+	//     origin: Scriabin
+	//     tags: [hexatonic]
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/go-muse/muse/degree"
 	"github.com/go-muse/muse/halftone"
 	"github.com/go-muse/muse/note"
 	"github.com/go-muse/muse/scale"
@@ -31,6 +32,26 @@ func TestModeTemplatesStore(t *testing.T) {
 	}
 }
 
+func TestTemplatesStore_FindModeTemplatesByDegrees(t *testing.T) {
+	mts := InitTemplatesStore()
+
+	pentatonics := mts.FindModeTemplatesByDegrees(DegreesInPentatonic)
+	assert.Len(t, pentatonics, 10)
+	assert.True(t, pentatonics.Contains(NamePentatonicHirajoshi))
+
+	symmetric := mts.FindModeTemplatesByDegrees(DegreesInHexatonic, DegreesInOctatonic)
+	for _, modeName := range []Name{NameWholeTone, NameAugmented, NameDiminishedHalfWhole, NameBebopDominant, NameMessiaenMode4, NameMessiaenMode5} {
+		assert.True(t, symmetric.Contains(modeName), "expected mode name: %s", modeName)
+	}
+	for _, modeTemplate := range symmetric {
+		assert.Contains(t, []degree.Number{DegreesInHexatonic, DegreesInOctatonic}, modeTemplate.Length())
+	}
+
+	assert.Len(t, mts.FindModeTemplatesByDegrees(9, 10), 2)
+	assert.Empty(t, mts.FindModeTemplatesByDegrees())
+	assert.Empty(t, mts.FindModeTemplatesByDegrees(11))
+}
+
 func TestFindModeTemplatesByPattern(t *testing.T) {
 	mts := make(TemplatesStore)
 
@@ -91,8 +112,10 @@ func TestFindModeTemplatesByNotes(t *testing.T) {
 		expectedModes []Name
 	}{
 		{
-			notes:         note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.F, note.G, note.A, note.B),
-			expectedModes: []Name{NameAeolian, NameIonian, NamePhrygian, NameLocrian, NameDorian, NameLydian, NameMixoLydian, NameNaturalMajor, NameNaturalMinor},
+			notes: note.MustNewNotesFromNoteNames(note.C, note.D, note.E, note.F, note.G, note.A, note.B),
+			expectedModes: []Name{NameAeolian, NameIonian, NamePhrygian, NameLocrian, NameDorian, NameLydian, NameMixoLydian, NameNaturalMajor, NameNaturalMinor,
+				// Bebop modes from C and G contain the passing tones out of the notes
+				NameBebopDominant, NameBebopDominant, NameBebopDorian, NameBebopDorian, NameBebopMajor},
 		},
 	}
 
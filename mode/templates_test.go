@@ -36,6 +36,22 @@ func TestGetTemplateByNames(t *testing.T) {
 			modeName: NameMelodicMajor,
 			expected: TemplateMelodicMajor(),
 		},
+		{
+			modeName: NameBluesHexatonic,
+			expected: Template{3, 2, 1, 1, 3, 2},
+		},
+		{
+			modeName: NameDiminishedWholeHalf,
+			expected: Template{2, 1, 2, 1, 2, 1, 2, 1},
+		},
+		{
+			modeName: NameMessiaenMode1,
+			expected: TemplateWholeTone(),
+		},
+		{
+			modeName: NameMessiaenMode7,
+			expected: Template{1, 1, 1, 2, 1, 1, 1, 1, 2, 1},
+		},
 	}
 
 	var modeTemplate Template
@@ -0,0 +1,107 @@
+package mode
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+)
+
+// Template returns the template of the mode calculated by halftones between its degrees.
+func (m *Mode) Template() Template {
+	firstDegree := m.GetFirstDegree()
+	if firstDegree == nil {
+		return nil
+	}
+
+	template := make(Template, 0, m.Length())
+	var previous halftone.HalfTones
+	for d := range firstDegree.IterateOneRound(false) {
+		if d.Number() == 1 {
+			continue
+		}
+		template = append(template, d.HalfTonesFromPrime()-previous)
+		previous = d.HalfTonesFromPrime()
+	}
+
+	return append(template, halftone.HalfTonesInOctave-previous)
+}
+
+// Transpose returns a new mode with the first note moved by the interval in the given direction.
+// The mode is rebuilt from the new first note, so the spelling of the notes stays correct:
+// C major transposed up by augmented unison is C# major and by minor second is Db major.
+func (m *Mode) Transpose(ic *interval.Chromatic, direction interval.Direction) (*Mode, error) {
+	firstNote := m.GetNoteByDegreeNum(1)
+	if firstNote == nil {
+		return nil, fmt.Errorf("transpose mode '%s': %w", m.Name(), ErrDegreeNumberInvalid)
+	}
+
+	tonic, err := interval.TransposeNote(firstNote, ic, direction)
+	if err != nil {
+		return nil, fmt.Errorf("transpose mode '%s': %w", m.Name(), err)
+	}
+
+	return m.rebuild(tonic)
+}
+
+// TransposeToKey returns a new mode with the given first note (tonic).
+// The spelling of the tonic is simplified and, if its enharmonic equivalent makes the mode with fewer accidentals,
+// the equivalent is chosen, e.g. major from G# becomes Ab major.
+func (m *Mode) TransposeToKey(tonic *note.Note) (*Mode, error) {
+	if m.GetFirstDegree() == nil {
+		return nil, fmt.Errorf("transpose mode '%s': %w", m.Name(), ErrDegreeNumberInvalid)
+	}
+
+	if tonic == nil {
+		return nil, fmt.Errorf("transpose mode '%s' to empty tonic: %w", m.Name(), note.ErrNoteNameUnknown)
+	}
+
+	simplified := tonic.Simplify()
+	best, err := m.rebuild(simplified)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, degrees := range []int{-1, 1} {
+		equivalent, err := simplified.TransposeBySpelling(0, degrees)
+		if err != nil || equivalent.GetAlterationShift()*equivalent.GetAlterationShift() > 1 {
+			continue
+		}
+
+		candidate, err := m.rebuild(equivalent)
+		if err != nil {
+			continue
+		}
+
+		if candidate.countAccidentals() < best.countAccidentals() {
+			best = candidate
+		}
+	}
+
+	return best, nil
+}
+
+// rebuild builds the mode with the same name and template from the given first note.
+func (m *Mode) rebuild(firstNote *note.Note) (*Mode, error) {
+	modeTemplate := m.Template()
+	if err := modeTemplate.Validate(); err != nil {
+		return nil, fmt.Errorf("validate template of mode '%s': %w", m.Name(), err)
+	}
+
+	return newModeBuilder(modeTemplate).build(m.Name(), firstNote), nil
+}
+
+// countAccidentals returns total amount of sharps and flats in the notes of the mode.
+func (m *Mode) countAccidentals() int {
+	var count int
+	for d := range m.IterateOneRound(false) {
+		alteration := int(d.Note().GetAlterationShiftInQuarterTones())
+		if alteration < 0 {
+			alteration = -alteration
+		}
+		count += alteration
+	}
+
+	return count
+}
@@ -0,0 +1,92 @@
+package mode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/scale"
+)
+
+func TestMode_Template(t *testing.T) {
+	assert.Equal(t, TemplateNaturalMajor(), MustMakeNewMode(NameNaturalMajor, note.C).Template())
+	assert.Equal(t, TemplateHarmonicMinor(), MustMakeNewMode(NameHarmonicMinor, note.FSHARP).Template())
+	assert.Equal(t, TemplatePentatonicMajor(), MustMakeNewMode(NamePentatonicMajor, note.D).Template())
+	assert.Nil(t, (*Mode)(nil).Template())
+}
+
+func TestMode_Transpose(t *testing.T) {
+	testCases := []struct {
+		mode      *Mode
+		interval  *interval.Chromatic
+		direction interval.Direction
+		want      scale.Scale
+	}{
+		{
+			mode: MustMakeNewMode(NameNaturalMajor, note.C), interval: interval.AugmentedUnison(),
+			want: scale.MustNewScaleFromNoteNames(note.CSHARP, note.DSHARP, note.ESHARP, note.FSHARP, note.GSHARP, note.ASHARP, note.BSHARP),
+		},
+		{
+			mode: MustMakeNewMode(NameNaturalMajor, note.C), interval: interval.MinorSecond(),
+			want: scale.MustNewScaleFromNoteNames(note.DFLAT, note.EFLAT, note.F, note.GFLAT, note.AFLAT, note.BFLAT, note.C),
+		},
+		{
+			mode: MustMakeNewMode(NameHarmonicMinor, note.A), interval: interval.MajorSecond(), direction: interval.DirectionDescending,
+			want: scale.MustNewScaleFromNoteNames(note.G, note.A, note.BFLAT, note.C, note.D, note.EFLAT, note.FSHARP),
+		},
+		{
+			mode: MustMakeNewMode(NamePentatonicMinor, note.A), interval: interval.PerfectFourth(),
+			want: scale.MustNewScaleFromNoteNames(note.D, note.F, note.G, note.A, note.C),
+		},
+	}
+
+	for _, testCase := range testCases {
+		transposed, err := testCase.mode.Transpose(testCase.interval, testCase.direction)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.mode.Name(), transposed.Name())
+		assert.Equal(t, testCase.want, transposed.GenerateScale(false))
+	}
+
+	_, err := MustMakeNewMode(NameNaturalMajor, note.C).Transpose(nil, interval.DirectionAscending)
+	require.ErrorIs(t, err, interval.ErrIntervalUnknown)
+
+	_, err = (*Mode)(nil).Transpose(interval.MinorSecond(), interval.DirectionAscending)
+	require.ErrorIs(t, err, ErrDegreeNumberInvalid)
+}
+
+func TestMode_TransposeToKey(t *testing.T) {
+	testCases := []struct {
+		mode  *Mode
+		tonic note.Name
+		want  scale.Scale
+	}{
+		{
+			mode: MustMakeNewMode(NameNaturalMajor, note.C), tonic: note.GSHARP,
+			want: scale.MustNewScaleFromNoteNames(note.AFLAT, note.BFLAT, note.C, note.DFLAT, note.EFLAT, note.F, note.G),
+		},
+		{
+			mode: MustMakeNewMode(NameNaturalMajor, note.C), tonic: note.FSHARP2,
+			want: scale.MustNewScaleFromNoteNames(note.G, note.A, note.B, note.C, note.D, note.E, note.FSHARP),
+		},
+		{
+			mode: MustMakeNewMode(NameNaturalMinor, note.A), tonic: note.GSHARP,
+			want: scale.MustNewScaleFromNoteNames(note.GSHARP, note.ASHARP, note.B, note.CSHARP, note.DSHARP, note.E, note.FSHARP),
+		},
+		{
+			mode: MustMakeNewMode(NameNaturalMajor, note.C), tonic: note.ASHARP,
+			want: scale.MustNewScaleFromNoteNames(note.BFLAT, note.C, note.D, note.EFLAT, note.F, note.G, note.A),
+		},
+	}
+
+	for _, testCase := range testCases {
+		transposed, err := testCase.mode.TransposeToKey(note.MustNewNote(testCase.tonic))
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, transposed.GenerateScale(false), testCase.tonic)
+	}
+
+	_, err := MustMakeNewMode(NameNaturalMajor, note.C).TransposeToKey(nil)
+	require.ErrorIs(t, err, note.ErrNoteNameUnknown)
+}
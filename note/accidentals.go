@@ -1,5 +1,7 @@
 package note
 
+import "strings"
+
 // Accidental is a type for the accidental symbol. It represents a sharp, flat, or natural note.
 type Accidental string
 
@@ -14,6 +16,68 @@ func (as Accidental) String() string {
 	return string(as)
 }
 
+// Quarter-tone accidentals. The half-sharp and half-flat are written after the sharps and flats,
+// so the sesqui-sharp (three quarter tones up) is "#+" and the sesqui-flat (three quarter tones down) is "bd".
+const (
+	AccidentalHalfSharp   = Accidental("+")
+	AccidentalHalfFlat    = Accidental("d")
+	AccidentalSesquiSharp = AccidentalSharp + AccidentalHalfSharp
+	AccidentalSesquiFlat  = AccidentalFlat + AccidentalHalfFlat
+)
+
+// QuarterTonesInHalfTone is amount of quarter tones in a halftone.
+const QuarterTonesInHalfTone = 2
+
+// QuarterTones returns the shift of pitch by the accidental in quarter tones. Sign means direction of alteration.
+func (as Accidental) QuarterTones() int8 {
+	var quarterTones int8
+	for _, symbol := range as {
+		switch Accidental(symbol) {
+		case AccidentalSharp:
+			quarterTones += QuarterTonesInHalfTone
+		case AccidentalFlat:
+			quarterTones -= QuarterTonesInHalfTone
+		case AccidentalHalfSharp:
+			quarterTones++
+		case AccidentalHalfFlat:
+			quarterTones--
+		}
+	}
+
+	return quarterTones
+}
+
+// NewAccidentalByQuarterTones returns the accidental shifting pitch by the given amount of quarter tones.
+// Whole halftones are written with sharps or flats, the odd quarter tone is written with the half-sharp or half-flat.
+func NewAccidentalByQuarterTones(quarterTones int8) Accidental {
+	switch {
+	case quarterTones > 0:
+		return Accidental(strings.Repeat(AccidentalSharp.String(), int(quarterTones/QuarterTonesInHalfTone)) +
+			strings.Repeat(AccidentalHalfSharp.String(), int(quarterTones%QuarterTonesInHalfTone)))
+	case quarterTones < 0:
+		return Accidental(strings.Repeat(AccidentalFlat.String(), int(-quarterTones/QuarterTonesInHalfTone)) +
+			strings.Repeat(AccidentalHalfFlat.String(), int(-quarterTones%QuarterTonesInHalfTone)))
+	}
+
+	return ""
+}
+
+// Unicode returns the accidental written with the musical symbols: ♯, ♭, 𝄪, 𝄫 and 𝄲, 𝄳 for quarter tones.
+func (as Accidental) Unicode() string {
+	sharp, doubleSharp, quarter := "♯", "𝄪", "𝄲"
+	quarterTones := as.QuarterTones()
+	if quarterTones < 0 {
+		sharp, doubleSharp, quarter = "♭", "𝄫", "𝄳"
+		quarterTones = -quarterTones
+	}
+
+	halfTones := int(quarterTones / QuarterTonesInHalfTone)
+
+	return strings.Repeat(doubleSharp, halfTones/2) + //nolint:mnd
+		strings.Repeat(sharp, halfTones%2) + //nolint:mnd
+		strings.Repeat(quarter, int(quarterTones%QuarterTonesInHalfTone))
+}
+
 // GetNotesWithAlterations returns the provided notes, each altered upward and downward by the specified number of semitones.
 //
 // Parameters:
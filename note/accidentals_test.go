@@ -40,3 +40,32 @@ func TestGetNotesWithAlterations(t *testing.T) {
 		})
 	}
 }
+
+func TestAccidentalQuarterTones(t *testing.T) {
+	testCases := []struct {
+		accidental   Accidental
+		quarterTones int8
+		unicode      string
+	}{
+		{accidental: "", quarterTones: 0, unicode: ""},
+		{accidental: AccidentalHalfSharp, quarterTones: 1, unicode: "𝄲"},
+		{accidental: AccidentalSharp, quarterTones: 2, unicode: "♯"},
+		{accidental: AccidentalSesquiSharp, quarterTones: 3, unicode: "♯𝄲"},
+		{accidental: "##", quarterTones: 4, unicode: "𝄪"},
+		{accidental: "##+", quarterTones: 5, unicode: "𝄪𝄲"},
+		{accidental: "###", quarterTones: 6, unicode: "𝄪♯"},
+		{accidental: AccidentalHalfFlat, quarterTones: -1, unicode: "𝄳"},
+		{accidental: AccidentalFlat, quarterTones: -2, unicode: "♭"},
+		{accidental: AccidentalSesquiFlat, quarterTones: -3, unicode: "♭𝄳"},
+		{accidental: "bb", quarterTones: -4, unicode: "𝄫"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.quarterTones, testCase.accidental.QuarterTones(), "accidental: %s", testCase.accidental)
+		assert.Equal(t, testCase.unicode, testCase.accidental.Unicode(), "accidental: %s", testCase.accidental)
+		assert.Equal(t, testCase.accidental, NewAccidentalByQuarterTones(testCase.quarterTones), "quarter tones: %d", testCase.quarterTones)
+	}
+
+	assert.Equal(t, "#+", AccidentalSesquiSharp.String())
+	assert.Equal(t, "bd", AccidentalSesquiFlat.String())
+}
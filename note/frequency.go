@@ -2,6 +2,8 @@ package note
 
 import (
 	"math"
+
+	"github.com/go-muse/muse/halftone"
 )
 
 // Standards frequencies of A4 in Hz.
@@ -58,25 +60,56 @@ func (n *Note) Frequency(standard float64) float64 {
 		return 0
 	}
 
-	baseName := n.BaseName()
-	accidentals := int8(0)
-	for _, char := range n.Name()[1:] {
-		if char == rune(AccidentalSharp[0]) {
-			accidentals++
-		} else if char == rune(AccidentalFlat[0]) {
-			accidentals--
-		}
+	semitoneOffset, exists := getMapOfHalfTones()[n.BaseName()]
+	if !exists {
+		return 0
 	}
 
-	semitoneOffset, exists := getMapOfHalfTones()[baseName]
+	// Quarter-tone accidentals are taken into account, so the calculation is made in quarter tones
+	quarterTones := int(semitoneOffset)*QuarterTonesInHalfTone + int(n.GetAlterationShiftInQuarterTones()) +
+		int(halftone.EDO24)*(int(n.Octave().Number())-4) //nolint:mnd
+
+	// f = 440 * 2^(n/24), where n — quarter tones from A4 (A in first octave)
+	return halftone.EDO24.Frequency(standard, quarterTones)
+}
+
+// getMapOfWholeTonesAndSemitones returns map that contains the amount of whole tones and diatonic semitones
+// between A4 and each natural note of the fourth octave.
+func getMapOfWholeTonesAndSemitones() map[Name][2]int {
+	return map[Name][2]int{
+		C: {-4, -1},
+		D: {-3, -1},
+		E: {-2, -1},
+		F: {-2, 0},
+		G: {-1, 0},
+		A: {0, 0},
+		B: {1, 0},
+	}
+}
+
+// FrequencyInEDO returns the frequency of the note in the given equal division of the octave.
+// The natural notes are placed by the whole tones and diatonic semitones of the division,
+// and each sharp or flat shifts the note by its chromatic semitone, so in 19-EDO or 31-EDO C# and Db are different pitches.
+// Quarter-tone accidentals shift the note by a half of the chromatic semitone, which may lie between the steps of the division.
+// In 12-EDO the result is the same as Frequency.
+func (n *Note) FrequencyInEDO(standard float64, edo halftone.EDO) float64 {
+	if n == nil || n.Octave() == nil || edo.Validate() != nil {
+		return 0
+	}
+
+	tonesAndSemitones, exists := getMapOfWholeTonesAndSemitones()[n.BaseName()]
 	if !exists {
 		return 0
 	}
 
-	totalSemitones := semitoneOffset + accidentals
+	steps := tonesAndSemitones[0]*int(edo.WholeTone()) +
+		tonesAndSemitones[1]*int(edo.DiatonicSemitone()) +
+		int(edo.StepsInOctave())*(int(n.Octave().Number())-4) //nolint:mnd
+
+	// Steps are counted in halves to keep quarter-tone alterations exact
+	halfSteps := steps*QuarterTonesInHalfTone + int(n.GetAlterationShiftInQuarterTones())*int(edo.ChromaticSemitone())
 
-	// f = 440 * 2^(n/12), where n — halftones from A4 (A in first octave)
-	return standard * math.Pow(2, float64(totalSemitones+12*(int8(n.Octave().Number())-4))/12)
+	return standard * math.Pow(2, float64(halfSteps)/float64(QuarterTonesInHalfTone*int(edo))) //nolint:mnd
 }
 
 // FrequencyBy444 returns the frequency of the note calculated in the 444 Hz standard.
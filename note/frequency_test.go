@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/go-muse/muse/halftone"
 	"github.com/go-muse/muse/octave"
 )
 
@@ -54,6 +55,21 @@ func TestNoteFrequency(t *testing.T) {
 			note:     &Note{name: B, octave: octave.MustNewByNumber(8)},
 			expected: 7902.08,
 		},
+		{
+			name:     "Ed4",
+			note:     &Note{name: EHALFFLAT, octave: octave.MustNewByNumber(4)},
+			expected: 320.24,
+		},
+		{
+			name:     "F#+4",
+			note:     &Note{name: "F#+", octave: octave.MustNewByNumber(4)},
+			expected: 380.84,
+		},
+		{
+			name:     "Bbd3",
+			note:     &Note{name: "Bbd", octave: octave.MustNewByNumber(3)},
+			expected: 226.45,
+		},
 		{
 			name:     "B9",
 			note:     &Note{name: B, octave: octave.MustNewByNumber(9)},
@@ -68,3 +84,33 @@ func TestNoteFrequency(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteFrequencyInEDO(t *testing.T) {
+	t.Run("12-EDO is the same as the twelve-tone frequency", func(t *testing.T) {
+		for _, name := range []Name{C, CSHARP, DFLAT, E, FSHARP2, GFLAT2, A, BFLAT, B, BSHARP} {
+			for octaveNumber := octave.Number(-1); octaveNumber <= 9; octaveNumber++ {
+				n := &Note{name: name, octave: octave.MustNewByNumber(octaveNumber)}
+				assert.InEpsilon(t, n.FrequencyBy440(), n.FrequencyInEDO(FreqA440, halftone.EDO12), 0.000001)
+			}
+		}
+	})
+
+	t.Run("31-EDO", func(t *testing.T) {
+		a4 := &Note{name: A, octave: octave.MustNewByNumber(4)}
+		a5 := &Note{name: A, octave: octave.MustNewByNumber(5)}
+		csharp4 := &Note{name: CSHARP, octave: octave.MustNewByNumber(4)}
+		dflat4 := &Note{name: DFLAT, octave: octave.MustNewByNumber(4)}
+
+		assert.InDelta(t, 440.0, a4.FrequencyInEDO(FreqA440, halftone.EDO31), 0.000001)
+		assert.InDelta(t, 880.0, a5.FrequencyInEDO(FreqA440, halftone.EDO31), 0.000001)
+		// C# is 21 steps below A4, Db is 20 steps below A4
+		assert.InDelta(t, halftone.EDO31.Frequency(FreqA440, -21), csharp4.FrequencyInEDO(FreqA440, halftone.EDO31), 0.000001)
+		assert.InDelta(t, halftone.EDO31.Frequency(FreqA440, -20), dflat4.FrequencyInEDO(FreqA440, halftone.EDO31), 0.000001)
+	})
+
+	t.Run("empty cases", func(t *testing.T) {
+		assert.Zero(t, (*Note)(nil).FrequencyInEDO(FreqA440, halftone.EDO19))
+		assert.Zero(t, (&Note{name: C}).FrequencyInEDO(FreqA440, halftone.EDO19))
+		assert.Zero(t, (&Note{name: C, octave: octave.MustNewByNumber(4)}).FrequencyInEDO(FreqA440, 0))
+	})
+}
@@ -0,0 +1,156 @@
+package note
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Locale is a language of the names of notes, modes and intervals.
+type Locale string
+
+const (
+	// LocaleEnglish is the English naming with letters and accidentals: C#, Bb.
+	LocaleEnglish = Locale("en")
+	// LocaleGerman is the German naming: B is H, Bb is B, sharps and flats are written with "is" and "es": Fis, Es.
+	LocaleGerman = Locale("de")
+	// LocaleItalian is the fixed-do solfège: Do, Re, Mi with accidentals: Do#, Sib.
+	LocaleItalian = Locale("it")
+	// LocaleSpanish is the fixed-do solfège used in Spain and Latin America, written as the Italian one.
+	LocaleSpanish = Locale("es")
+	// LocaleRussian is the fixed-do solfège in Cyrillic with the accidentals written as words: до-диез, си-бемоль.
+	LocaleRussian = Locale("ru")
+)
+
+// ErrLocaleUnknown is returned when the locale is not supported.
+var ErrLocaleUnknown = errors.New("unknown locale")
+
+// maxLocalizedAlteration is the widest alteration having the localized name: double sharp or double flat.
+const maxLocalizedAlteration = 2
+
+// String is stringer for Locale type.
+func (l Locale) String() string {
+	return string(l)
+}
+
+// Validate checks that the locale is supported.
+func (l Locale) Validate() error {
+	switch l {
+	case LocaleEnglish, LocaleGerman, LocaleItalian, LocaleSpanish, LocaleRussian:
+		return nil
+	}
+
+	return fmt.Errorf("locale '%s': %w", l, ErrLocaleUnknown)
+}
+
+// getSolfegeSyllables returns fixed-do syllables of the natural notes in Latin and Cyrillic.
+func getSolfegeSyllables() map[Locale]map[Name]string {
+	latin := map[Name]string{C: "Do", D: "Re", E: "Mi", F: "Fa", G: "Sol", A: "La", B: "Si"}
+
+	return map[Locale]map[Name]string{
+		LocaleItalian: latin,
+		LocaleSpanish: latin,
+		LocaleRussian: {C: "до", D: "ре", E: "ми", F: "фа", G: "соль", A: "ля", B: "си"},
+	}
+}
+
+// formatLocalized returns the name of the natural note altered by the given amount of halftones in the locale.
+func formatLocalized(base Name, halfTones int, locale Locale) string {
+	switch locale {
+	case LocaleGerman:
+		return formatGerman(base, halfTones)
+	case LocaleRussian:
+		suffix := map[int]string{-2: "-дубль-бемоль", -1: "-бемоль", 1: "-диез", 2: "-дубль-диез"}
+
+		return getSolfegeSyllables()[locale][base] + suffix[halfTones]
+	case LocaleItalian, LocaleSpanish:
+		return getSolfegeSyllables()[locale][base] + string(NewAccidentalByQuarterTones(int8(halfTones*QuarterTonesInHalfTone))) //nolint:gosec // alteration is limited by double accidentals
+	}
+
+	return base.String() + string(NewAccidentalByQuarterTones(int8(halfTones*QuarterTonesInHalfTone))) //nolint:gosec // alteration is limited by double accidentals
+}
+
+// formatGerman returns the German name of the natural note altered by the given amount of halftones.
+func formatGerman(base Name, halfTones int) string {
+	switch {
+	case halfTones > 0 && base == B:
+		return "H" + strings.Repeat("is", halfTones)
+	case halfTones > 0:
+		return base.String() + strings.Repeat("is", halfTones)
+	case halfTones == 0 && base == B:
+		return "H"
+	case halfTones == 0:
+		return base.String()
+	}
+
+	// Flats of B, E and A are contracted: B, Heses, Es, Eses, As, Asas
+	switch base {
+	case B:
+		if halfTones == -1 {
+			return "B"
+		}
+
+		return "H" + strings.Repeat("es", -halfTones)
+	case E, A:
+		return base.String() + "s" + strings.Repeat(strings.ToLower(base.String())+"s", -halfTones-1)
+	}
+
+	return base.String() + strings.Repeat("es", -halfTones)
+}
+
+// Format returns the note name in the locale, e.g. "Fis" for F# and "H" for B in German, "Sib" for Bb in Italian.
+// Only names with up to double sharps or double flats can be formatted, microtonal names are not localized.
+func (nn Name) Format(locale Locale) (string, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	if err := nn.Validate(); err != nil {
+		return "", fmt.Errorf("format note name '%s': %w", nn, err)
+	}
+
+	quarterTones := nn.Accidental().QuarterTones()
+	halfTones := int(quarterTones / QuarterTonesInHalfTone)
+	if nn.IsMicrotonal() || halfTones > maxLocalizedAlteration || halfTones < -maxLocalizedAlteration {
+		return "", fmt.Errorf("format note name '%s' in locale '%s': %w", nn, locale, ErrNoteNameUnknown)
+	}
+
+	return formatLocalized(nn[0:1], halfTones, locale), nil
+}
+
+// getLocalizedNames returns note names by their lowercase localized names.
+// Besides the names given by Format, alternative spellings are accepted: Unicode accidentals, German "Ases" and "Bes".
+func getLocalizedNames(locale Locale) map[string]Name {
+	names := make(map[string]Name)
+	for _, base := range getBaseNames() {
+		for halfTones := -maxLocalizedAlteration; halfTones <= maxLocalizedAlteration; halfTones++ {
+			nn := base + Name(NewAccidentalByQuarterTones(int8(halfTones*QuarterTonesInHalfTone))) //nolint:gosec // alteration is limited by double accidentals
+			names[strings.ToLower(formatLocalized(base, halfTones, locale))] = nn
+		}
+	}
+
+	if locale == LocaleGerman {
+		names["ases"] = A + Name(AccidentalFlat+AccidentalFlat)
+		names["bes"] = B + Name(AccidentalFlat+AccidentalFlat)
+	}
+
+	return names
+}
+
+// ParseName returns the note name by its name in the locale, e.g. F# for "Fis" in German and Bb for "Sib" in Italian.
+// Parsing is case-insensitive, and the sharps and flats may be written with Unicode symbols: "Do♯", "Si♭".
+func ParseName(s string, locale Locale) (Name, error) {
+	if err := locale.Validate(); err != nil {
+		return "", err
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	normalized = strings.NewReplacer("♯", AccidentalSharp.String(), "♭", AccidentalFlat.String(),
+		"𝄪", AccidentalSharp.String()+AccidentalSharp.String(), "𝄫", AccidentalFlat.String()+AccidentalFlat.String()).Replace(normalized)
+
+	if nn, ok := getLocalizedNames(locale)[normalized]; ok {
+		return nn, nil
+	}
+
+	return "", fmt.Errorf("parse note name '%s' in locale '%s': %w", s, locale, ErrNoteNameUnknown)
+}
@@ -0,0 +1,105 @@
+package note
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestName_Format(t *testing.T) {
+	testCases := []struct {
+		name     Name
+		locale   Locale
+		expected string
+	}{
+		{name: C, locale: LocaleEnglish, expected: "C"},
+		{name: BFLAT, locale: LocaleEnglish, expected: "Bb"},
+		{name: B, locale: LocaleGerman, expected: "H"},
+		{name: BFLAT, locale: LocaleGerman, expected: "B"},
+		{name: "Bbb", locale: LocaleGerman, expected: "Heses"},
+		{name: BSHARP, locale: LocaleGerman, expected: "His"},
+		{name: FSHARP, locale: LocaleGerman, expected: "Fis"},
+		{name: "F##", locale: LocaleGerman, expected: "Fisis"},
+		{name: EFLAT, locale: LocaleGerman, expected: "Es"},
+		{name: "Ebb", locale: LocaleGerman, expected: "Eses"},
+		{name: AFLAT, locale: LocaleGerman, expected: "As"},
+		{name: "Abb", locale: LocaleGerman, expected: "Asas"},
+		{name: DFLAT, locale: LocaleGerman, expected: "Des"},
+		{name: C, locale: LocaleItalian, expected: "Do"},
+		{name: GSHARP, locale: LocaleItalian, expected: "Sol#"},
+		{name: BFLAT, locale: LocaleSpanish, expected: "Sib"},
+		{name: "Ebb", locale: LocaleSpanish, expected: "Mibb"},
+		{name: CSHARP, locale: LocaleRussian, expected: "до-диез"},
+		{name: BFLAT, locale: LocaleRussian, expected: "си-бемоль"},
+		{name: "G##", locale: LocaleRussian, expected: "соль-дубль-диез"},
+		{name: A, locale: LocaleRussian, expected: "ля"},
+	}
+
+	for _, testCase := range testCases {
+		formatted, err := testCase.name.Format(testCase.locale)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, formatted, "name: %s, locale: %s", testCase.name, testCase.locale)
+	}
+
+	_, err := C.Format("fr")
+	require.ErrorIs(t, err, ErrLocaleUnknown)
+
+	_, err = EHALFFLAT.Format(LocaleGerman)
+	require.ErrorIs(t, err, ErrNoteNameUnknown)
+
+	_, err = Name("C###").Format(LocaleItalian)
+	require.ErrorIs(t, err, ErrNoteNameUnknown)
+
+	_, err = Name("X").Format(LocaleItalian)
+	require.ErrorIs(t, err, ErrNoteNameUnknown)
+}
+
+func TestParseName(t *testing.T) {
+	testCases := []struct {
+		s        string
+		locale   Locale
+		expected Name
+	}{
+		{s: "Bb", locale: LocaleEnglish, expected: BFLAT},
+		{s: "F♯", locale: LocaleEnglish, expected: FSHARP},
+		{s: "H", locale: LocaleGerman, expected: B},
+		{s: "b", locale: LocaleGerman, expected: BFLAT},
+		{s: "Fis", locale: LocaleGerman, expected: FSHARP},
+		{s: "es", locale: LocaleGerman, expected: EFLAT},
+		{s: "Ases", locale: LocaleGerman, expected: "Abb"},
+		{s: "Bes", locale: LocaleGerman, expected: "Bbb"},
+		{s: "Do", locale: LocaleItalian, expected: C},
+		{s: "SOL#", locale: LocaleItalian, expected: GSHARP},
+		{s: "Si♭", locale: LocaleSpanish, expected: BFLAT},
+		{s: "До-диез", locale: LocaleRussian, expected: CSHARP},
+		{s: " ми-бемоль ", locale: LocaleRussian, expected: EFLAT},
+	}
+
+	for _, testCase := range testCases {
+		parsed, err := ParseName(testCase.s, testCase.locale)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, parsed, "s: %s, locale: %s", testCase.s, testCase.locale)
+	}
+
+	_, err := ParseName("H", LocaleEnglish)
+	require.ErrorIs(t, err, ErrNoteNameUnknown)
+
+	_, err = ParseName("Do", LocaleGerman)
+	require.ErrorIs(t, err, ErrNoteNameUnknown)
+
+	_, err = ParseName("C", "fr")
+	require.ErrorIs(t, err, ErrLocaleUnknown)
+
+	t.Run("ParseName: formatted names are parsed back", func(t *testing.T) {
+		for _, locale := range []Locale{LocaleEnglish, LocaleGerman, LocaleItalian, LocaleSpanish, LocaleRussian} {
+			for _, n := range GetNotesWithAlterations(GetSetFullChromatic(), 1) {
+				formatted, err := n.Name().Format(locale)
+				require.NoError(t, err)
+				parsed, err := ParseName(formatted, locale)
+				require.NoError(t, err)
+				assert.Equal(t, n.Name(), parsed, "formatted: %s, locale: %s", formatted, locale)
+			}
+		}
+	})
+}
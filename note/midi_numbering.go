@@ -15,6 +15,7 @@ const (
 )
 
 // MIDINumber returns note number coded by unsigned integer in range [0; 127] according to RFC 6295.
+// MIDI has no quarter tones, so quarter-tone accidentals are truncated toward the natural note.
 func (n *Note) MIDINumber() uint8 {
 	if n == nil || n.octave == nil {
 		return minMIDINumber
@@ -2,6 +2,7 @@ package note
 
 import (
 	"errors"
+	"strings"
 )
 
 // Name is a common name for the note.
@@ -68,15 +69,52 @@ func (nn Name) Validate() error {
 		return ErrNoteNameUnknown
 	}
 
-	if len(nn) > 1 {
-		firstAlterationSymbol := string(nn[1:2])
-		for _, alterationSymbol := range nn[1:] {
-			if string(alterationSymbol) != firstAlterationSymbol ||
-				(firstAlterationSymbol != AccidentalFlat.String() && firstAlterationSymbol != AccidentalSharp.String()) {
+	// Sharps or flats can be followed by a single quarter-tone accidental in the same direction
+	accidental := nn[1:]
+	quarterTone := Name("")
+	if strings.HasSuffix(accidental.String(), AccidentalHalfSharp.String()) ||
+		strings.HasSuffix(accidental.String(), AccidentalHalfFlat.String()) {
+		quarterTone = accidental[len(accidental)-1:]
+		accidental = accidental[:len(accidental)-1]
+	}
+
+	if len(accidental) > 0 {
+		firstAlterationSymbol := accidental[0:1]
+		for _, alterationSymbol := range accidental {
+			if Name(alterationSymbol) != firstAlterationSymbol ||
+				(firstAlterationSymbol != Name(AccidentalFlat) && firstAlterationSymbol != Name(AccidentalSharp)) {
 				return ErrNoteNameUnknown
 			}
 		}
+
+		if (quarterTone == Name(AccidentalHalfSharp) && firstAlterationSymbol != Name(AccidentalSharp)) ||
+			(quarterTone == Name(AccidentalHalfFlat) && firstAlterationSymbol != Name(AccidentalFlat)) {
+			return ErrNoteNameUnknown
+		}
 	}
 
 	return nil
 }
+
+// Accidental returns the accidental part of the note name.
+func (nn Name) Accidental() Accidental {
+	if len(nn) <= 1 {
+		return ""
+	}
+
+	return Accidental(nn[1:])
+}
+
+// IsMicrotonal checks if the note name contains a quarter-tone accidental.
+func (nn Name) IsMicrotonal() bool {
+	return nn.Accidental().QuarterTones()%QuarterTonesInHalfTone != 0
+}
+
+// Unicode returns the note name with the accidentals written by the musical symbols, e.g. "E𝄳" or "F♯𝄲".
+func (nn Name) Unicode() string {
+	if len(nn) < 1 {
+		return ""
+	}
+
+	return nn[0:1].String() + nn.Accidental().Unicode()
+}
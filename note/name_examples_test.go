@@ -2,6 +2,7 @@ package note_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-muse/muse/note"
 )
@@ -18,3 +19,36 @@ func ExampleName_NewNote() {
 	fmt.Println(note1.Name(), note2.Name())
 	// Output: C Ab
 }
+
+// Quarter-tone accidentals are written after sharps and flats: "+" is a half-sharp and "d" is a half-flat.
+func ExampleName_Unicode() {
+	rast := note.MustNewNotesFromNoteNames(note.C, note.D, note.EHALFFLAT, note.F, note.G, note.A, note.BHALFFLAT)
+	names := make([]string, 0, len(rast))
+	for _, n := range rast {
+		names = append(names, n.Name().Unicode())
+	}
+	fmt.Println(strings.Join(names, " "))
+
+	fmt.Println(note.MustNewNote("F#+").Name().Unicode(), note.MustNewNote("Bbd").Name().Unicode())
+	// Output: C D E𝄳 F G A B𝄳
+	// F♯𝄲 B♭𝄳
+}
+
+// Note names can be formatted and parsed in German and with the fixed-do solfège.
+func ExampleName_Format() {
+	names := note.Names{note.B, note.BFLAT, note.FSHARP, note.EFLAT}
+	for _, nn := range names {
+		german, _ := nn.Format(note.LocaleGerman)
+		italian, _ := nn.Format(note.LocaleItalian)
+		russian, _ := nn.Format(note.LocaleRussian)
+		fmt.Println(nn, german, italian, russian)
+	}
+
+	nn, _ := note.ParseName("Fis", note.LocaleGerman)
+	fmt.Println(nn)
+	// Output: B H Si си
+	// Bb B Sib си-бемоль
+	// F# Fis Fa# фа-диез
+	// Eb Es Mib ми-бемоль
+	// F#
+}
@@ -82,6 +82,20 @@ func TestNoteName_Validate(t *testing.T) {
 		{noteName: "##b", want: false},
 		{noteName: "#bb", want: false},
 		{noteName: "###", want: false},
+		{noteName: "A+", want: true},
+		{noteName: "Ad", want: true},
+		{noteName: "A#+", want: true},
+		{noteName: "Abd", want: true},
+		{noteName: "A##+", want: true},
+		{noteName: "Dd", want: true},
+		{noteName: "Ab+", want: false},
+		{noteName: "A#d", want: false},
+		{noteName: "A++", want: false},
+		{noteName: "Add", want: false},
+		{noteName: "A+#", want: false},
+		{noteName: "Adb", want: false},
+		{noteName: "+", want: false},
+		{noteName: "d", want: false},
 	}
 
 	for _, note := range GetNotesWithAlterations(GetSetFullChromatic(), 2) {
@@ -96,3 +110,27 @@ func TestNoteName_Validate(t *testing.T) {
 		}
 	}
 }
+
+func TestNoteName_Unicode(t *testing.T) {
+	testCases := map[Name]string{
+		"":        "",
+		C:         "C",
+		CSHARP:    "C♯",
+		BFLAT2:    "B𝄫",
+		EHALFFLAT: "E𝄳",
+		"F#+":     "F♯𝄲",
+		"Bbd":     "B♭𝄳",
+	}
+
+	for noteName, expected := range testCases {
+		assert.Equal(t, expected, noteName.Unicode())
+	}
+}
+
+func TestNoteName_IsMicrotonal(t *testing.T) {
+	assert.True(t, EHALFFLAT.IsMicrotonal())
+	assert.True(t, Name("C#+").IsMicrotonal())
+	assert.False(t, CSHARP.IsMicrotonal())
+	assert.False(t, C.IsMicrotonal())
+	assert.False(t, Name("").IsMicrotonal())
+}
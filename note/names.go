@@ -118,3 +118,49 @@ const (
 	// ESHARP2 is the name of the note "E#".
 	ESHARP2 = Name("E##")
 )
+
+// Quarter-tone alterations
+
+const (
+	// CHALFFLAT is the name of the note "Cd".
+	CHALFFLAT = Name("Cd")
+
+	// CHALFSHARP is the name of the note "C+".
+	CHALFSHARP = Name("C+")
+
+	// DHALFFLAT is the name of the note "Dd".
+	DHALFFLAT = Name("Dd")
+
+	// DHALFSHARP is the name of the note "D+".
+	DHALFSHARP = Name("D+")
+
+	// EHALFFLAT is the name of the note "Ed".
+	EHALFFLAT = Name("Ed")
+
+	// EHALFSHARP is the name of the note "E+".
+	EHALFSHARP = Name("E+")
+
+	// FHALFFLAT is the name of the note "Fd".
+	FHALFFLAT = Name("Fd")
+
+	// FHALFSHARP is the name of the note "F+".
+	FHALFSHARP = Name("F+")
+
+	// GHALFFLAT is the name of the note "Gd".
+	GHALFFLAT = Name("Gd")
+
+	// GHALFSHARP is the name of the note "G+".
+	GHALFSHARP = Name("G+")
+
+	// AHALFFLAT is the name of the note "Ad".
+	AHALFFLAT = Name("Ad")
+
+	// AHALFSHARP is the name of the note "A+".
+	AHALFSHARP = Name("A+")
+
+	// BHALFFLAT is the name of the note "Bd".
+	BHALFFLAT = Name("Bd")
+
+	// BHALFSHARP is the name of the note "B+".
+	BHALFSHARP = Name("B+")
+)
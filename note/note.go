@@ -2,7 +2,6 @@ package note
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -140,34 +139,33 @@ func (n *Note) Copy() *Note {
 
 // AlterUp alters the note upwards.
 func (n *Note) AlterUp() *Note {
-	if n == nil {
-		return nil
-	}
-
-	if len(n.name) > 1 && strings.HasSuffix(n.name.String(), string(AccidentalFlat)) {
-		n.name = n.name[:len(n.name)-len(AccidentalFlat)]
+	return n.AlterByQuarterTones(QuarterTonesInHalfTone)
+}
 
-		return n
-	}
+// AlterDown alters the note downwards.
+func (n *Note) AlterDown() *Note {
+	return n.AlterByQuarterTones(-QuarterTonesInHalfTone)
+}
 
-	n.name = Name(fmt.Sprintf("%s%s", n.name, AccidentalSharp))
+// AlterUpByQuarterTone alters the note upwards by a quarter tone, e.g. E becomes E+ and Ed becomes E.
+func (n *Note) AlterUpByQuarterTone() *Note {
+	return n.AlterByQuarterTones(1)
+}
 
-	return n
+// AlterDownByQuarterTone alters the note downwards by a quarter tone, e.g. E becomes Ed and E# becomes E+.
+func (n *Note) AlterDownByQuarterTone() *Note {
+	return n.AlterByQuarterTones(-1)
 }
 
-// AlterDown alters the note downwards.
-func (n *Note) AlterDown() *Note {
+// AlterByQuarterTones alters the note by the given amount of quarter tones. Sign means direction of alteration.
+// The base name of the note is kept, and the accidentals are rewritten.
+func (n *Note) AlterByQuarterTones(quarterTones int8) *Note {
 	if n == nil {
 		return nil
 	}
 
-	if len(n.name) > 1 && strings.HasSuffix(n.name.String(), string(AccidentalSharp)) {
-		n.name = n.name[:len(n.name)-len(AccidentalSharp)]
-
-		return n
-	}
-
-	n.name = Name(fmt.Sprintf("%s%s", n.name, AccidentalFlat))
+	accidental := NewAccidentalByQuarterTones(n.GetAlterationShiftInQuarterTones() + quarterTones)
+	n.name = n.BaseName() + Name(accidental)
 
 	return n
 }
@@ -203,31 +201,71 @@ func (n *Note) BaseName() Name {
 	return n.name[0:1]
 }
 
-// GetAlterationShift returns information about alteration of the note (up or down). Sign means direction of alteration.
+// GetAlterationShift returns information about alteration of the note (up or down) in halftones. Sign means direction of alteration.
+// Quarter-tone accidentals are truncated toward the natural note, e.g. the shift of "C#+" is 1.
 func (n *Note) GetAlterationShift() int8 {
-	var shift int8
+	return n.GetAlterationShiftInQuarterTones() / QuarterTonesInHalfTone
+}
 
-	if len(n.name) <= 1 {
+// GetAlterationShiftInQuarterTones returns alteration of the note in quarter tones. Sign means direction of alteration.
+func (n *Note) GetAlterationShiftInQuarterTones() int8 {
+	if n == nil {
 		return 0
 	}
 
-	if strings.HasSuffix(n.name.String(), string(AccidentalSharp)) {
-		for i := len(n.name[1:]); i > 0; i-- {
-			shift++
-		}
+	return n.name.Accidental().QuarterTones()
+}
+
+// IsMicrotonal checks if the note is altered by a quarter-tone accidental.
+func (n *Note) IsMicrotonal() bool {
+	return n.Name().IsMicrotonal()
+}
+
+// quarterTonesFromC returns the position of the note in quarter tones from C of the note's octave.
+func (n *Note) quarterTonesFromC() int {
+	return int(n.getBaseNoteNumberWithinOctave())*QuarterTonesInHalfTone + int(n.GetAlterationShiftInQuarterTones())
+}
 
-		return shift
+// Compare compares pitches of the notes, taking into account quarter-tone accidentals.
+// It returns -1 if the note is lower than the given one, 1 if it is higher and 0 if they sound the same.
+// Octaves are taken into account only if both notes have them.
+func (n *Note) Compare(note *Note) int {
+	if n == nil || note == nil {
+		return 0
 	}
 
-	if strings.HasSuffix(n.name.String(), string(AccidentalFlat)) {
-		for i := len(n.name[1:]); i > 0; i-- {
-			shift--
-		}
+	position, otherPosition := n.quarterTonesFromC(), note.quarterTonesFromC()
+	if n.octave != nil && note.octave != nil {
+		const quarterTonesInOctave = int(octave.NotesInOctave) * QuarterTonesInHalfTone
+		position += int(n.octave.Number()) * quarterTonesInOctave
+		otherPosition += int(note.octave.Number()) * quarterTonesInOctave
+	}
 
-		return shift
+	switch {
+	case position < otherPosition:
+		return -1
+	case position > otherPosition:
+		return 1
 	}
 
-	return shift
+	return 0
+}
+
+// IsEnharmonic checks if the notes sound the same, e.g. E+ and Fd, or C#+ and Dd.
+// Notes without octaves are compared within the octave, so B# and C are enharmonic.
+func (n *Note) IsEnharmonic(note *Note) bool {
+	if n == nil || note == nil {
+		return false
+	}
+
+	if n.octave != nil && note.octave != nil {
+		return n.Compare(note) == 0
+	}
+
+	const quarterTonesInOctave = int(octave.NotesInOctave) * QuarterTonesInHalfTone
+	difference := n.quarterTonesFromC() - note.quarterTonesFromC()
+
+	return difference%quarterTonesInOctave == 0
 }
 
 // SetOctave sets the specified octave to the note and returns the note.
@@ -781,3 +781,113 @@ func TestNoteGetPartOfBarByRel(t *testing.T) {
 		assert.True(t, testCase.want.Equal(testCase.note.GetPartOfBarByValue(testCase.timeSignature)), "expected: %+v, actual: %+v", testCase.want, testCase.note.value.GetPartOfBar(testCase.timeSignature))
 	}
 }
+
+func TestNoteQuarterToneAlterations(t *testing.T) {
+	testCases := []struct {
+		name         string
+		note         *Note
+		expectedName Name
+	}{
+		{name: "E up by quarter tone", note: newNote(E).AlterUpByQuarterTone(), expectedName: EHALFSHARP},
+		{name: "E down by quarter tone", note: newNote(E).AlterDownByQuarterTone(), expectedName: EHALFFLAT},
+		{name: "Ed up by quarter tone", note: newNote(EHALFFLAT).AlterUpByQuarterTone(), expectedName: E},
+		{name: "E# down by quarter tone", note: newNote(ESHARP).AlterDownByQuarterTone(), expectedName: EHALFSHARP},
+		{name: "E+ up by quarter tone", note: newNote(EHALFSHARP).AlterUpByQuarterTone(), expectedName: ESHARP},
+		{name: "E# up by quarter tone", note: newNote(ESHARP).AlterUpByQuarterTone(), expectedName: "E#+"},
+		{name: "Eb down by quarter tone", note: newNote(EFLAT).AlterDownByQuarterTone(), expectedName: "Ebd"},
+		{name: "Ed up by halftone", note: newNote(EHALFFLAT).AlterUp(), expectedName: EHALFSHARP},
+		{name: "E+ down by halftone", note: newNote(EHALFSHARP).AlterDown(), expectedName: EHALFFLAT},
+		{name: "C#+ down by 5 quarter tones", note: newNote("C#+").AlterByQuarterTones(-5), expectedName: CFLAT},
+		{name: "B by zero", note: newNote(B).AlterByQuarterTones(0), expectedName: B},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedName, testCase.note.Name())
+		})
+	}
+
+	var n *Note
+	assert.Nil(t, n.AlterByQuarterTones(1))
+	assert.Nil(t, n.AlterUpByQuarterTone())
+}
+
+func TestNoteGetAlterationShiftInQuarterTones(t *testing.T) {
+	testCases := []struct {
+		name         Name
+		shift        int8
+		quarterTones int8
+		microtonal   bool
+	}{
+		{name: C, shift: 0, quarterTones: 0},
+		{name: CHALFSHARP, shift: 0, quarterTones: 1, microtonal: true},
+		{name: CSHARP, shift: 1, quarterTones: 2},
+		{name: "C#+", shift: 1, quarterTones: 3, microtonal: true},
+		{name: CSHARP2, shift: 2, quarterTones: 4},
+		{name: CHALFFLAT, shift: 0, quarterTones: -1, microtonal: true},
+		{name: CFLAT, shift: -1, quarterTones: -2},
+		{name: "Cbd", shift: -1, quarterTones: -3, microtonal: true},
+	}
+
+	for _, testCase := range testCases {
+		n := MustNewNote(testCase.name)
+		assert.Equal(t, testCase.shift, n.GetAlterationShift(), "note: %s", testCase.name)
+		assert.Equal(t, testCase.quarterTones, n.GetAlterationShiftInQuarterTones(), "note: %s", testCase.name)
+		assert.Equal(t, testCase.microtonal, n.IsMicrotonal(), "note: %s", testCase.name)
+	}
+
+	var n *Note
+	assert.Zero(t, n.GetAlterationShiftInQuarterTones())
+}
+
+func TestNoteCompare(t *testing.T) {
+	testCases := []struct {
+		name       string
+		note1      *Note
+		note2      *Note
+		compare    int
+		enharmonic bool
+	}{
+		{name: "E+ and Fd", note1: MustNewNote(EHALFSHARP), note2: MustNewNote(FHALFFLAT), compare: 0, enharmonic: true},
+		{name: "C#+ and Dd", note1: MustNewNote("C#+"), note2: MustNewNote(DHALFFLAT), compare: 0, enharmonic: true},
+		{name: "Ed and Eb", note1: MustNewNote(EHALFFLAT), note2: MustNewNote(EFLAT), compare: 1, enharmonic: false},
+		{name: "Ed and E", note1: MustNewNote(EHALFFLAT), note2: MustNewNote(E), compare: -1, enharmonic: false},
+		{name: "B# and C without octaves", note1: MustNewNote(BSHARP), note2: MustNewNote(C), compare: 1, enharmonic: true},
+		{name: "B#3 and C4", note1: MustNewNoteWithOctave(BSHARP, 3), note2: MustNewNoteWithOctave(C, 4), compare: 0, enharmonic: true},
+		{name: "Bd3 and C4", note1: MustNewNoteWithOctave(BHALFFLAT, 3), note2: MustNewNoteWithOctave(C, 4), compare: -1, enharmonic: false},
+		{name: "C5 and B+4", note1: MustNewNoteWithOctave(C, 5), note2: MustNewNoteWithOctave(BHALFSHARP, 4), compare: 1, enharmonic: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.compare, testCase.note1.Compare(testCase.note2))
+			assert.Equal(t, -testCase.compare, testCase.note2.Compare(testCase.note1))
+			assert.Equal(t, testCase.enharmonic, testCase.note1.IsEnharmonic(testCase.note2))
+		})
+	}
+
+	var n *Note
+	assert.Zero(t, n.Compare(MustNewNote(C)))
+	assert.False(t, n.IsEnharmonic(MustNewNote(C)))
+}
+
+func TestNote_Positions(t *testing.T) {
+	testCases := []struct {
+		note                *Note
+		baseNameIndex       int
+		diatonic, chromatic int
+	}{
+		{note: MustNewNote(C), baseNameIndex: 0, diatonic: 0, chromatic: 0},
+		{note: MustNewNote(BSHARP), baseNameIndex: 6, diatonic: 6, chromatic: 12},
+		{note: MustNewNoteWithOctave(C, octave.Number4), baseNameIndex: 0, diatonic: 28, chromatic: 48},
+		{note: MustNewNoteWithOctave(EFLAT, octave.Number4), baseNameIndex: 2, diatonic: 30, chromatic: 51},
+		{note: MustNewNoteWithOctave(CFLAT, octave.Number4), baseNameIndex: 0, diatonic: 28, chromatic: 47},
+		{note: MustNewNoteWithOctave(A, octave.NumberMinus1), baseNameIndex: 5, diatonic: -2, chromatic: -3},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.baseNameIndex, testCase.note.BaseNameIndex(), string(testCase.note.Name()))
+		assert.Equal(t, testCase.diatonic, testCase.note.DiatonicPosition(), string(testCase.note.Name()))
+		assert.Equal(t, testCase.chromatic, testCase.note.ChromaticPosition(), string(testCase.note.Name()))
+	}
+}
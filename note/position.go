@@ -0,0 +1,55 @@
+package note
+
+import "github.com/go-muse/muse/octave"
+
+// baseNamesInOctave is amount of natural notes (letters) in octave.
+const baseNamesInOctave = 7
+
+// BaseNameIndex returns index of the note's letter within octave: 0 for C, 1 for D, ..., 6 for B.
+func (n *Note) BaseNameIndex() int {
+	if n == nil {
+		return 0
+	}
+
+	switch n.BaseName() {
+	case D:
+		return 1
+	case E:
+		return 2 //nolint:mnd
+	case F:
+		return 3 //nolint:mnd
+	case G:
+		return 4 //nolint:mnd
+	case A:
+		return 5 //nolint:mnd
+	case B:
+		return 6 //nolint:mnd
+	}
+
+	return 0
+}
+
+// octaveNumberOrZero returns number of the note's octave or zero if the note has no octave.
+func (n *Note) octaveNumberOrZero() int {
+	if n == nil || n.octave == nil {
+		return 0
+	}
+
+	return int(n.octave.Number())
+}
+
+// DiatonicPosition returns position of the note's letter counted in natural notes from C of octave 0.
+// Notes without octave are considered as notes of octave 0.
+func (n *Note) DiatonicPosition() int {
+	return n.BaseNameIndex() + baseNamesInOctave*n.octaveNumberOrZero()
+}
+
+// ChromaticPosition returns position of the note counted in halftones from C of octave 0.
+// Notes without octave are considered as notes of octave 0. Quarter-tone accidentals are truncated toward the natural note.
+func (n *Note) ChromaticPosition() int {
+	if n == nil {
+		return 0
+	}
+
+	return int(n.getBaseNoteNumberWithinOctave()) + int(n.GetAlterationShift()) + int(octave.NotesInOctave)*n.octaveNumberOrZero()
+}
@@ -0,0 +1,118 @@
+package note
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-muse/muse/octave"
+)
+
+// ErrTranspositionOutOfRange is returned when the transposed note needs more than double sharp or double flat,
+// or when it goes beyond the known octaves.
+var ErrTranspositionOutOfRange = errors.New("transposed note is out of range")
+
+// maxTranspositionQuarterTones is the widest alteration of the transposed note: double sharp or double flat.
+const maxTranspositionQuarterTones = 2 * QuarterTonesInHalfTone
+
+// getBaseNames returns natural notes of the octave in their order from C.
+func getBaseNames() Names {
+	return Names{C, D, E, F, G, A, B}
+}
+
+// TransposeBySpelling returns a copy of the note moved by the given amount of halftones and letter names (degrees).
+// Negative amounts move the note down. The letter name of the result is defined by degrees
+// and the accidentals are calculated to get the required halftones, so C moved by 3 halftones and 1 degree is D#,
+// and by 3 halftones and 2 degrees is Eb. Quarter-tone accidentals of the note are kept.
+// If the note has an octave, the octave of the result changes when the note passes C.
+func (n *Note) TransposeBySpelling(halfTones, degrees int) (*Note, error) {
+	if n == nil {
+		return nil, fmt.Errorf("transpose note: %w", ErrNoteNameUnknown)
+	}
+
+	baseNames := getBaseNames()
+	position := n.BaseNameIndex() + degrees
+	octaveShift := position / baseNamesInOctave
+	if position%baseNamesInOctave < 0 {
+		octaveShift--
+	}
+	targetBaseName := baseNames[position-octaveShift*baseNamesInOctave]
+
+	targetBase := &Note{name: targetBaseName}
+	naturalHalfTones := int(targetBase.getBaseNoteNumberWithinOctave()) - int(n.getBaseNoteNumberWithinOctave()) +
+		octaveShift*int(octave.NotesInOctave)
+	quarterTones := int(n.GetAlterationShiftInQuarterTones()) + (halfTones-naturalHalfTones)*QuarterTonesInHalfTone
+	if quarterTones > maxTranspositionQuarterTones || quarterTones < -maxTranspositionQuarterTones {
+		return nil, fmt.Errorf("transpose note '%s' by %d halftones and %d degrees: %w", n.Name(), halfTones, degrees, ErrTranspositionOutOfRange)
+	}
+
+	transposed := n.Copy()
+	transposed.name = targetBaseName + Name(NewAccidentalByQuarterTones(int8(quarterTones))) //nolint:gosec // checked above
+
+	if n.octave != nil {
+		oct, err := octave.NewByNumber(n.octave.Number() + octave.Number(octaveShift)) //nolint:gosec // octave shift is small
+		if err != nil {
+			return nil, fmt.Errorf("transpose note '%s' by %d halftones and %d degrees: %w: %w", n.Name(), halfTones, degrees, ErrTranspositionOutOfRange, err)
+		}
+		transposed.octave = oct
+	}
+
+	return transposed, nil
+}
+
+// Simplify returns a copy of the note with the simplest enharmonic spelling:
+// double sharps and double flats are removed, and E#, B#, Fb, Cb become F, C, E, B.
+// Sharps stay sharps and flats stay flats, so C## becomes D and A## becomes B. Notes with quarter-tone accidentals are copied as is.
+// If the note has an octave, it is changed when the spelling passes C, e.g. B#4 becomes C5.
+func (n *Note) Simplify() *Note {
+	if n == nil {
+		return nil
+	}
+
+	alteration := n.GetAlterationShift()
+	if n.IsMicrotonal() || alteration == 0 || (alteration*alteration == 1 && !n.isAwkwardlySpelled()) {
+		return n.Copy()
+	}
+
+	const maxDegrees = 2
+	candidates := make(Notes, 0, 2*maxDegrees+1)
+	for degrees := -maxDegrees; degrees <= maxDegrees; degrees++ {
+		if candidate, err := n.TransposeBySpelling(0, degrees); err == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// The natural note is the simplest one
+	for _, candidate := range candidates {
+		if candidate.GetAlterationShift() == 0 {
+			return candidate
+		}
+	}
+
+	// Otherwise, the single accidental in the direction of the original one
+	for _, candidate := range candidates {
+		if candidate.GetAlterationShift() == alteration/abs(alteration) && !candidate.isAwkwardlySpelled() {
+			return candidate
+		}
+	}
+
+	return n.Copy()
+}
+
+// isAwkwardlySpelled checks if the note is one of E#, B#, Fb and Cb that have natural enharmonic equivalents.
+func (n *Note) isAwkwardlySpelled() bool {
+	switch n.Name() {
+	case ESHARP, BSHARP, FFLAT, CFLAT:
+		return true
+	}
+
+	return false
+}
+
+// abs returns the absolute value of the alteration.
+func abs(alteration int8) int8 {
+	if alteration < 0 {
+		return -alteration
+	}
+
+	return alteration
+}
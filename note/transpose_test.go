@@ -0,0 +1,78 @@
+package note
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/octave"
+)
+
+func TestNote_TransposeBySpelling(t *testing.T) {
+	testCases := []struct {
+		note               *Note
+		halfTones, degrees int
+		want               *Note
+	}{
+		{note: MustNewNote(C), halfTones: 3, degrees: 1, want: MustNewNote(DSHARP)},
+		{note: MustNewNote(C), halfTones: 3, degrees: 2, want: MustNewNote(EFLAT)},
+		{note: MustNewNote(B), halfTones: 1, degrees: 1, want: MustNewNote(C)},
+		{note: MustNewNote(EFLAT), halfTones: -3, degrees: -2, want: MustNewNote(C)},
+		{note: MustNewNote(FSHARP), halfTones: 7, degrees: 4, want: MustNewNote(CSHARP)},
+		{note: MustNewNote(EHALFFLAT), halfTones: 7, degrees: 4, want: MustNewNote(BHALFFLAT)},
+		{note: MustNewNoteWithOctave(B, octave.Number3), halfTones: 1, degrees: 1, want: MustNewNoteWithOctave(C, octave.Number4)},
+		{note: MustNewNoteWithOctave(C, octave.Number4), halfTones: -1, degrees: -1, want: MustNewNoteWithOctave(B, octave.Number3)},
+		{note: MustNewNoteWithOctave(C, octave.Number4), halfTones: 16, degrees: 9, want: MustNewNoteWithOctave(E, octave.Number5)},
+		{note: MustNewNoteWithOctave(A, octave.Number4), halfTones: -15, degrees: -9, want: MustNewNoteWithOctave(FSHARP, octave.Number3)},
+		{note: MustNewNoteWithOctave(CFLAT, octave.Number4), halfTones: 0, degrees: -1, want: MustNewNoteWithOctave(B, octave.Number3)},
+	}
+
+	for _, testCase := range testCases {
+		transposed, err := testCase.note.TransposeBySpelling(testCase.halfTones, testCase.degrees)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.want, transposed)
+	}
+
+	n := MustNewNote(C).SetDuration(1)
+	transposed, err := n.TransposeBySpelling(2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, C, n.Name())
+	assert.Equal(t, n.Duration(), transposed.Duration())
+
+	_, err = MustNewNote(CSHARP2).TransposeBySpelling(1, 0)
+	require.ErrorIs(t, err, ErrTranspositionOutOfRange)
+
+	_, err = MustNewNoteWithOctave(B, octave.Number9).TransposeBySpelling(1, 1)
+	require.ErrorIs(t, err, ErrTranspositionOutOfRange)
+
+	_, err = (*Note)(nil).TransposeBySpelling(1, 1)
+	require.Error(t, err)
+}
+
+func TestNote_Simplify(t *testing.T) {
+	testCases := []struct {
+		note *Note
+		want *Note
+	}{
+		{note: MustNewNote(C), want: MustNewNote(C)},
+		{note: MustNewNote(CSHARP), want: MustNewNote(CSHARP)},
+		{note: MustNewNote(EFLAT), want: MustNewNote(EFLAT)},
+		{note: MustNewNote(CSHARP2), want: MustNewNote(D)},
+		{note: MustNewNote(DFLAT2), want: MustNewNote(C)},
+		{note: MustNewNote(ESHARP), want: MustNewNote(F)},
+		{note: MustNewNote(FFLAT), want: MustNewNote(E)},
+		{note: MustNewNote(ESHARP2), want: MustNewNote(FSHARP)},
+		{note: MustNewNote(FFLAT2), want: MustNewNote(EFLAT)},
+		{note: MustNewNote(EHALFFLAT), want: MustNewNote(EHALFFLAT)},
+		{note: MustNewNoteWithOctave(BSHARP, octave.Number4), want: MustNewNoteWithOctave(C, octave.Number5)},
+		{note: MustNewNoteWithOctave(CFLAT, octave.Number4), want: MustNewNoteWithOctave(B, octave.Number3)},
+	}
+
+	for _, testCase := range testCases {
+		simplified := testCase.note.Simplify()
+		assert.Equal(t, testCase.want, simplified)
+	}
+
+	assert.Nil(t, (*Note)(nil).Simplify())
+}
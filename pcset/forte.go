@@ -0,0 +1,282 @@
+package pcset
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ForteNumber is the name of a set class in Allen Forte's list: cardinality and ordinal number of the set class,
+// with "Z" mark for the set classes having a Z-related pair, e.g. 3-11 for major and minor triads or 4-Z15.
+type ForteNumber struct {
+	Cardinality uint8
+	Ordinal     uint8
+	Z           bool
+}
+
+// ErrForteNumberUnknown is returned when the Forte number can't be parsed or is not in the list.
+var ErrForteNumberUnknown = errors.New("unknown forte number")
+
+// String returns the Forte number in the form "4-Z15".
+func (fn ForteNumber) String() string {
+	z := ""
+	if fn.Z {
+		z = "Z"
+	}
+
+	return fmt.Sprintf("%d-%s%d", fn.Cardinality, z, fn.Ordinal)
+}
+
+// ParseForteNumber parses the Forte number in the form "4-Z15". The "Z" mark is optional.
+func ParseForteNumber(s string) (ForteNumber, error) {
+	parsed, err := parseForteNumber(strings.ToUpper(strings.TrimSpace(s)))
+	if err != nil {
+		return ForteNumber{}, fmt.Errorf("parse '%s': %w: %w", s, ErrForteNumberUnknown, err)
+	}
+
+	// The "Z" mark is taken from the list
+	for _, z := range []bool{false, true} {
+		fn := ForteNumber{Cardinality: parsed.Cardinality, Ordinal: parsed.Ordinal, Z: z}
+		if _, ok := getPrimeForms()[fn]; ok {
+			return fn, nil
+		}
+	}
+
+	return ForteNumber{}, fmt.Errorf("got: '%s': %w", s, ErrForteNumberUnknown)
+}
+
+// parseForteNumber parses the Forte number in the form "4-Z15" without checking it in the list.
+func parseForteNumber(s string) (ForteNumber, error) {
+	cardinality, ordinal, _ := strings.Cut(s, "-")
+	c, err := strconv.ParseUint(cardinality, 10, 8)
+	if err != nil {
+		return ForteNumber{}, fmt.Errorf("parse cardinality: %w", err)
+	}
+
+	z := strings.HasPrefix(ordinal, "Z")
+	o, err := strconv.ParseUint(strings.TrimPrefix(ordinal, "Z"), 10, 8)
+	if err != nil {
+		return ForteNumber{}, fmt.Errorf("parse ordinal: %w", err)
+	}
+
+	return ForteNumber{Cardinality: uint8(c), Ordinal: uint8(o), Z: z}, nil
+}
+
+// FromForteNumber returns the prime form of the set class with the given Forte number, e.g. "3-11" or "6-Z29".
+func FromForteNumber(s string) (Set, error) {
+	fn, err := ParseForteNumber(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return getPrimeForms()[fn], nil
+}
+
+// ForteNumber returns the Forte number of the set class of the set.
+func (s Set) ForteNumber() ForteNumber {
+	return getForteTables().forteNumbers[s.PrimeForm()]
+}
+
+// getForteList returns Forte numbers and prime forms of the set classes of three to six pitch classes.
+// Pitch classes are written as hexadecimal digits, so "A" is 10.
+func getForteList() [][2]string {
+	return [][2]string{
+		{"3-1", "012"},
+		{"3-2", "013"},
+		{"3-3", "014"},
+		{"3-4", "015"},
+		{"3-5", "016"},
+		{"3-6", "024"},
+		{"3-7", "025"},
+		{"3-8", "026"},
+		{"3-9", "027"},
+		{"3-10", "036"},
+		{"3-11", "037"},
+		{"3-12", "048"},
+
+		{"4-1", "0123"},
+		{"4-2", "0124"},
+		{"4-3", "0134"},
+		{"4-4", "0125"},
+		{"4-5", "0126"},
+		{"4-6", "0127"},
+		{"4-7", "0145"},
+		{"4-8", "0156"},
+		{"4-9", "0167"},
+		{"4-10", "0235"},
+		{"4-11", "0135"},
+		{"4-12", "0236"},
+		{"4-13", "0136"},
+		{"4-14", "0237"},
+		{"4-Z15", "0146"},
+		{"4-16", "0157"},
+		{"4-17", "0347"},
+		{"4-18", "0147"},
+		{"4-19", "0148"},
+		{"4-20", "0158"},
+		{"4-21", "0246"},
+		{"4-22", "0247"},
+		{"4-23", "0257"},
+		{"4-24", "0248"},
+		{"4-25", "0268"},
+		{"4-26", "0358"},
+		{"4-27", "0258"},
+		{"4-28", "0369"},
+		{"4-Z29", "0137"},
+
+		{"5-1", "01234"},
+		{"5-2", "01235"},
+		{"5-3", "01245"},
+		{"5-4", "01236"},
+		{"5-5", "01237"},
+		{"5-6", "01256"},
+		{"5-7", "01267"},
+		{"5-8", "02346"},
+		{"5-9", "01246"},
+		{"5-10", "01346"},
+		{"5-11", "02347"},
+		{"5-Z12", "01356"},
+		{"5-13", "01248"},
+		{"5-14", "01257"},
+		{"5-15", "01268"},
+		{"5-16", "01347"},
+		{"5-Z17", "01348"},
+		{"5-Z18", "01457"},
+		{"5-19", "01367"},
+		{"5-20", "01568"},
+		{"5-21", "01458"},
+		{"5-22", "01478"},
+		{"5-23", "02357"},
+		{"5-24", "01357"},
+		{"5-25", "02358"},
+		{"5-26", "02458"},
+		{"5-27", "01358"},
+		{"5-28", "02368"},
+		{"5-29", "01368"},
+		{"5-30", "01468"},
+		{"5-31", "01369"},
+		{"5-32", "01469"},
+		{"5-33", "02468"},
+		{"5-34", "02469"},
+		{"5-35", "02479"},
+		{"5-Z36", "01247"},
+		{"5-Z37", "03458"},
+		{"5-Z38", "01258"},
+
+		{"6-1", "012345"},
+		{"6-2", "012346"},
+		{"6-Z3", "012356"},
+		{"6-Z4", "012456"},
+		{"6-5", "012367"},
+		{"6-Z6", "012567"},
+		{"6-7", "012678"},
+		{"6-8", "023457"},
+		{"6-9", "012357"},
+		{"6-Z10", "013457"},
+		{"6-Z11", "012457"},
+		{"6-Z12", "012467"},
+		{"6-Z13", "013467"},
+		{"6-14", "013458"},
+		{"6-15", "012458"},
+		{"6-16", "014568"},
+		{"6-Z17", "012478"},
+		{"6-18", "012578"},
+		{"6-Z19", "013478"},
+		{"6-20", "014589"},
+		{"6-21", "023468"},
+		{"6-22", "012468"},
+		{"6-Z23", "023568"},
+		{"6-Z24", "013468"},
+		{"6-Z25", "013568"},
+		{"6-Z26", "013578"},
+		{"6-27", "013469"},
+		{"6-Z28", "013569"},
+		{"6-Z29", "023679"},
+		{"6-30", "013679"},
+		{"6-31", "014579"},
+		{"6-32", "024579"},
+		{"6-33", "023579"},
+		{"6-34", "013579"},
+		{"6-35", "02468A"},
+		{"6-Z36", "012347"},
+		{"6-Z37", "012348"},
+		{"6-Z38", "012378"},
+		{"6-Z39", "023458"},
+		{"6-Z40", "012358"},
+		{"6-Z41", "012368"},
+		{"6-Z42", "012369"},
+		{"6-Z43", "012568"},
+		{"6-Z44", "012569"},
+		{"6-Z45", "023469"},
+		{"6-Z46", "012469"},
+		{"6-Z47", "012479"},
+		{"6-Z48", "012579"},
+		{"6-Z49", "013479"},
+		{"6-Z50", "014679"},
+	}
+}
+
+// forteTables contains prime forms of all the set classes by their Forte numbers and the Forte numbers by the prime forms.
+type forteTables struct {
+	primeForms   map[ForteNumber]Set
+	forteNumbers map[Set]ForteNumber
+}
+
+// getForteTables returns the tables of the set classes built once on the first call. The tables must not be modified.
+var getForteTables = sync.OnceValue(func() forteTables { //nolint:gochecknoglobals // the tables are built once
+	primeForms := buildPrimeForms()
+	forteNumbers := make(map[Set]ForteNumber, len(primeForms))
+	for fn, s := range primeForms {
+		forteNumbers[s] = fn
+	}
+
+	return forteTables{primeForms: primeForms, forteNumbers: forteNumbers}
+})
+
+// getPrimeForms returns prime forms of all the set classes by their Forte numbers. The map must not be modified.
+func getPrimeForms() map[ForteNumber]Set {
+	return getForteTables().primeForms
+}
+
+// buildPrimeForms builds prime forms of all the set classes by their Forte numbers.
+// Set classes of less than three pitch classes are numbered by their interval classes,
+// and set classes of more than six pitch classes take the numbers of their complements.
+func buildPrimeForms() map[ForteNumber]Set {
+	primeForms := map[ForteNumber]Set{
+		{Cardinality: 0, Ordinal: 1}: New(),
+		{Cardinality: 1, Ordinal: 1}: New(0),
+	}
+
+	for ic := PitchClass(1); ic <= PitchClassesInOctave/2; ic++ {
+		primeForms[ForteNumber{Cardinality: 2, Ordinal: uint8(ic)}] = New(0, ic) //nolint:mnd
+	}
+
+	for _, entry := range getForteList() {
+		fn, err := parseForteNumber(entry[0])
+		if err != nil {
+			panic(err)
+		}
+
+		var s Set
+		for _, digit := range entry[1] {
+			pc, err := strconv.ParseUint(string(digit), 16, 8) //nolint:mnd
+			if err != nil {
+				panic(err)
+			}
+			s |= New(PitchClass(pc))
+		}
+		primeForms[fn] = s
+	}
+
+	const halfOfPitchClasses = uint8(PitchClassesInOctave) / 2
+	for fn, s := range primeForms {
+		if fn.Cardinality < halfOfPitchClasses {
+			complement := ForteNumber{Cardinality: uint8(PitchClassesInOctave) - fn.Cardinality, Ordinal: fn.Ordinal, Z: fn.Z}
+			primeForms[complement] = s.Complement().PrimeForm()
+		}
+	}
+
+	return primeForms
+}
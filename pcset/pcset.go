@@ -0,0 +1,296 @@
+// Package pcset treats notes and mode templates as pitch-class sets of the twelve-tone equal temperament
+// and provides the tools of the musical set theory: normal and prime forms, Forte numbers, interval-class vectors,
+// transposition and inversion, complements, subsets and Z-relations.
+package pcset
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+)
+
+// PitchClass is a pitch class: 0 for C, 1 for C# and Db, ..., 11 for B.
+type PitchClass uint8
+
+// PitchClassesInOctave is amount of pitch classes in the twelve-tone equal temperament.
+const PitchClassesInOctave = PitchClass(halftone.HalfTonesInOctave)
+
+// newPitchClass returns the pitch class of any amount of halftones from C, including negative ones.
+func newPitchClass(halfTones int) PitchClass {
+	octave := int(PitchClassesInOctave)
+
+	return PitchClass(((halfTones % octave) + octave) % octave) //nolint:gosec // the value is within octave
+}
+
+// Set is a set of pitch classes stored as 12-bit mask: the bit n is set if the pitch class n is in the set.
+type Set uint16
+
+// fullSetMask is the mask of the set containing all the pitch classes.
+const fullSetMask = Set(1<<PitchClassesInOctave - 1)
+
+// ErrMicrotonalNote is returned when a note with quarter-tone accidentals is converted to a pitch class.
+var ErrMicrotonalNote = errors.New("microtonal note has no pitch class")
+
+// ErrPitchClassNotInSet is returned when the pitch class is expected to be in the set but it's not.
+var ErrPitchClassNotInSet = errors.New("pitch class is not in the set")
+
+// New creates the set of the given pitch classes. Pitch classes out of octave are reduced to it.
+func New(pitchClasses ...PitchClass) Set {
+	var s Set
+	for _, pc := range pitchClasses {
+		s |= 1 << (pc % PitchClassesInOctave)
+	}
+
+	return s
+}
+
+// FromNotes creates the set of pitch classes of the notes. Enharmonically equal notes give the same pitch class.
+func FromNotes(notes note.Notes) (Set, error) {
+	var s Set
+	for _, n := range notes {
+		if n == nil {
+			continue
+		}
+
+		if n.IsMicrotonal() {
+			return 0, fmt.Errorf("note '%s': %w", n.Name(), ErrMicrotonalNote)
+		}
+
+		s |= 1 << newPitchClass(n.ChromaticPosition())
+	}
+
+	return s, nil
+}
+
+// FromTemplate creates the set of pitch classes of the mode template built from C (pitch class 0).
+func FromTemplate(template mode.Template) (Set, error) {
+	if err := template.Validate(); err != nil {
+		return 0, fmt.Errorf("create pitch-class set: %w", err)
+	}
+
+	s := New(0)
+	var halfTonesFromPrime int
+	for _, halfTones := range template[:len(template)-1] {
+		halfTonesFromPrime += int(halfTones)
+		s |= 1 << newPitchClass(halfTonesFromPrime)
+	}
+
+	return s, nil
+}
+
+// Template returns the mode template built from the given pitch class of the set.
+func (s Set) Template(tonic PitchClass) (mode.Template, error) {
+	if !s.Contains(tonic) {
+		return nil, fmt.Errorf("tonic %d of set %s: %w", tonic, s, ErrPitchClassNotInSet)
+	}
+
+	pitchClasses := s.Transpose(-int(tonic)).PitchClasses()
+	template := make(mode.Template, 0, len(pitchClasses))
+	for i := 1; i < len(pitchClasses); i++ {
+		template = append(template, halftone.HalfTones(pitchClasses[i]-pitchClasses[i-1]))
+	}
+	template = append(template, halftone.HalfTones(PitchClassesInOctave-pitchClasses[len(pitchClasses)-1]))
+
+	return template, nil
+}
+
+// PitchClasses returns the pitch classes of the set in ascending order.
+func (s Set) PitchClasses() []PitchClass {
+	pitchClasses := make([]PitchClass, 0, s.Cardinality())
+	for pc := range PitchClassesInOctave {
+		if s.Contains(pc) {
+			pitchClasses = append(pitchClasses, pc)
+		}
+	}
+
+	return pitchClasses
+}
+
+// Cardinality returns amount of pitch classes in the set.
+func (s Set) Cardinality() int {
+	return bits.OnesCount16(uint16(s & fullSetMask))
+}
+
+// Contains checks if the pitch class is in the set.
+func (s Set) Contains(pc PitchClass) bool {
+	return pc < PitchClassesInOctave && s&(1<<pc) != 0
+}
+
+// String returns pitch classes of the set in the form "{0,4,7}".
+func (s Set) String() string {
+	return formatPitchClasses(s.PitchClasses())
+}
+
+// formatPitchClasses returns pitch classes in the form "{0,4,7}".
+func formatPitchClasses(pitchClasses []PitchClass) string {
+	parts := make([]string, len(pitchClasses))
+	for i, pc := range pitchClasses {
+		parts[i] = fmt.Sprint(pc)
+	}
+
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// Transpose returns the set transposed by the given amount of halftones (Tn operation).
+func (s Set) Transpose(halfTones int) Set {
+	n := uint(newPitchClass(halfTones))
+	s &= fullSetMask
+
+	return (s<<n | s>>(uint(PitchClassesInOctave)-n)) & fullSetMask
+}
+
+// Invert returns the set inverted around pitch class 0 (I operation): each pitch class n becomes 12-n.
+func (s Set) Invert() Set {
+	var inverted Set
+	for _, pc := range s.PitchClasses() {
+		inverted |= 1 << newPitchClass(-int(pc))
+	}
+
+	return inverted
+}
+
+// TransposeInverted returns the set inverted and then transposed by the given amount of halftones (TnI operation).
+func (s Set) TransposeInverted(halfTones int) Set {
+	return s.Invert().Transpose(halfTones)
+}
+
+// Complement returns the set of pitch classes that are not in the set.
+func (s Set) Complement() Set {
+	return ^s & fullSetMask
+}
+
+// IsSubsetOf checks if all the pitch classes of the set are in the other set.
+func (s Set) IsSubsetOf(other Set) bool {
+	return s&^other&fullSetMask == 0
+}
+
+// IsSupersetOf checks if all the pitch classes of the other set are in the set.
+func (s Set) IsSupersetOf(other Set) bool {
+	return other.IsSubsetOf(s)
+}
+
+// TranspositionTo returns the amount of halftones n for which Tn of the set gives the other set.
+// It returns false if there is no such transposition.
+func (s Set) TranspositionTo(other Set) (int, bool) {
+	for n := range int(PitchClassesInOctave) {
+		if s.Transpose(n) == other&fullSetMask {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// InversionTo returns the amount of halftones n for which TnI of the set gives the other set.
+// It returns false if there is no such inversion.
+func (s Set) InversionTo(other Set) (int, bool) {
+	return s.Invert().TranspositionTo(other)
+}
+
+// IsEquivalent checks if the sets belong to the same set class, so they are related by Tn or TnI operation.
+func (s Set) IsEquivalent(other Set) bool {
+	return s.PrimeForm() == other.PrimeForm()
+}
+
+// NormalForm returns the pitch classes of the set in the most compact ascending order.
+// Of the rotations with the smallest span the one packed to the left is chosen: intervals from the first pitch class
+// are compared from the last pitch class to the second (Rahn's method).
+// Rotations of the transpositionally symmetric sets are equal, so the one starting from the lowest pitch class is chosen.
+func (s Set) NormalForm() []PitchClass {
+	pitchClasses := s.PitchClasses()
+	if len(pitchClasses) == 0 {
+		return pitchClasses
+	}
+
+	best := pitchClasses
+	for i := 1; i < len(pitchClasses); i++ {
+		rotation := append(append([]PitchClass{}, pitchClasses[i:]...), pitchClasses[:i]...)
+		if compareFromRight(rotation, best) < 0 {
+			best = rotation
+		}
+	}
+
+	return best
+}
+
+// compareFromRight compares intervals from the first pitch class to the others from the last to the second one.
+func compareFromRight(a, b []PitchClass) int {
+	for i := len(a) - 1; i > 0; i-- {
+		intervalA, intervalB := newPitchClass(int(a[i])-int(a[0])), newPitchClass(int(b[i])-int(b[0]))
+		if intervalA != intervalB {
+			return int(intervalA) - int(intervalB)
+		}
+	}
+
+	return 0
+}
+
+// PrimeForm returns the prime form of the set: the most compact of the normal forms of the set and its inversion
+// transposed to begin on pitch class 0. Prime forms follow Rahn's method, so a few of them differ from Forte's list,
+// e.g. 5-20 is {0,1,5,6,8}, not {0,1,3,7,8}.
+func (s Set) PrimeForm() Set {
+	normal, inverted := s.NormalForm(), s.Invert().NormalForm()
+	if len(normal) == 0 {
+		return 0
+	}
+
+	best := normal
+	if compareFromRight(inverted, normal) < 0 {
+		best = inverted
+	}
+
+	return New(best...).Transpose(-int(best[0]))
+}
+
+// IntervalClassVector is amount of intervals of each interval class from 1 (minor second and major seventh)
+// to 6 (tritone) between all the pairs of pitch classes of a set.
+type IntervalClassVector [6]uint8
+
+// String returns the vector in the form "<001110>".
+func (icv IntervalClassVector) String() string {
+	var sb strings.Builder
+	sb.WriteString("<")
+	for _, amount := range icv {
+		sb.WriteString(fmt.Sprint(amount))
+	}
+	sb.WriteString(">")
+
+	return sb.String()
+}
+
+// IntervalClassVector returns the interval-class vector of the set.
+func (s Set) IntervalClassVector() IntervalClassVector {
+	var icv IntervalClassVector
+	pitchClasses := s.PitchClasses()
+	for i, pc1 := range pitchClasses {
+		for _, pc2 := range pitchClasses[i+1:] {
+			interval := min(pc2-pc1, PitchClassesInOctave-(pc2-pc1))
+			icv[interval-1]++
+		}
+	}
+
+	return icv
+}
+
+// IsZRelated checks if the sets have the same interval-class vector but belong to different set classes.
+func (s Set) IsZRelated(other Set) bool {
+	return s.IntervalClassVector() == other.IntervalClassVector() && !s.IsEquivalent(other)
+}
+
+// ZPartner returns the prime form of the set class that is Z-related to the set class of the set.
+// It returns false if the set has no Z-related set class.
+func (s Set) ZPartner() (Set, bool) {
+	icv, prime := s.IntervalClassVector(), s.PrimeForm()
+	for _, candidate := range getPrimeForms() {
+		if candidate.Cardinality() == s.Cardinality() && candidate != prime && candidate.IntervalClassVector() == icv {
+			return candidate, true
+		}
+	}
+
+	return 0, false
+}
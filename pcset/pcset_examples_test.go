@@ -0,0 +1,41 @@
+package pcset_test
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/pcset"
+)
+
+// Notes and mode templates can be analysed as pitch-class sets.
+func ExampleFromNotes() {
+	chord, err := pcset.FromNotes(note.MustNewNotesFromNoteNames(note.D, note.FSHARP, note.A, note.C))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(chord, chord.NormalForm(), chord.PrimeForm(), chord.ForteNumber(), chord.IntervalClassVector())
+
+	scale, err := pcset.FromTemplate(mode.TemplateIonian())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(scale.PrimeForm(), scale.ForteNumber(), chord.IsSubsetOf(scale), chord.Transpose(-7).IsSubsetOf(scale))
+	// Output:
+	// {0,2,6,9} [6 9 0 2] {0,2,5,8} 4-27 <012111>
+	// {0,1,3,5,6,8,10} 7-35 false true
+}
+
+// Z-related sets share the interval-class vector, though they are not transpositions or inversions of each other.
+func ExampleSet_ZPartner() {
+	allInterval, err := pcset.FromForteNumber("4-Z15")
+	if err != nil {
+		panic(err)
+	}
+
+	partner, ok := allInterval.ZPartner()
+	fmt.Println(allInterval, allInterval.IntervalClassVector(), partner, partner.IntervalClassVector(), partner.ForteNumber(), ok)
+	// Output: {0,1,4,6} <111111> {0,1,3,7} <111111> 4-Z29 true
+}
@@ -0,0 +1,196 @@
+package pcset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+)
+
+func TestFromNotes(t *testing.T) {
+	s, err := FromNotes(note.MustNewNotesFromNoteNames(note.C, note.E, note.G, note.BFLAT, note.ASHARP, note.BSHARP))
+	require.NoError(t, err)
+	assert.Equal(t, New(0, 4, 7, 10), s)
+	assert.Equal(t, "{0,4,7,10}", s.String())
+	assert.Equal(t, 4, s.Cardinality())
+
+	withOctaves := note.Notes{note.MustNewNoteWithOctave(note.CFLAT, 4), note.MustNewNoteWithOctave(note.D, 2), nil}
+	s, err = FromNotes(withOctaves)
+	require.NoError(t, err)
+	assert.Equal(t, New(11, 2), s)
+
+	_, err = FromNotes(note.Notes{note.MustNewNote(note.C).AlterUpByQuarterTone()})
+	require.ErrorIs(t, err, ErrMicrotonalNote)
+}
+
+func TestFromTemplate(t *testing.T) {
+	s, err := FromTemplate(mode.TemplateIonian())
+	require.NoError(t, err)
+	assert.Equal(t, New(0, 2, 4, 5, 7, 9, 11), s)
+
+	s, err = FromTemplate(mode.TemplatePentatonicMinor())
+	require.NoError(t, err)
+	assert.Equal(t, New(0, 3, 5, 7, 10), s)
+
+	_, err = FromTemplate(mode.Template{1, 2})
+	require.ErrorIs(t, err, mode.ErrInvalidModeTemplate)
+}
+
+func TestSet_Template(t *testing.T) {
+	s, err := FromTemplate(mode.TemplateIonian())
+	require.NoError(t, err)
+
+	template, err := s.Template(9)
+	require.NoError(t, err)
+	assert.Equal(t, mode.TemplateAeolian(), template)
+
+	template, err = s.Template(0)
+	require.NoError(t, err)
+	assert.Equal(t, mode.TemplateIonian(), template)
+
+	_, err = s.Template(1)
+	require.ErrorIs(t, err, ErrPitchClassNotInSet)
+}
+
+func TestSet_TranspositionAndInversion(t *testing.T) {
+	major := New(0, 4, 7)
+	assert.Equal(t, New(2, 6, 9), major.Transpose(2))
+	assert.Equal(t, New(11, 3, 6), major.Transpose(-1))
+	assert.Equal(t, New(1, 5, 8), major.Transpose(13))
+	assert.Equal(t, New(0, 8, 5), major.Invert())
+	assert.Equal(t, New(7, 3, 0), major.TransposeInverted(7))
+
+	n, ok := major.TranspositionTo(New(9, 1, 4))
+	assert.True(t, ok)
+	assert.Equal(t, 9, n)
+
+	_, ok = major.TranspositionTo(New(0, 3, 7))
+	assert.False(t, ok)
+
+	n, ok = major.InversionTo(New(0, 3, 7))
+	assert.True(t, ok)
+	assert.Equal(t, 7, n)
+
+	assert.True(t, major.IsEquivalent(New(0, 3, 7)))
+	assert.False(t, major.IsEquivalent(New(0, 3, 6)))
+}
+
+func TestSet_ComplementAndSubsets(t *testing.T) {
+	pentatonic := New(0, 2, 4, 7, 9)
+	diatonic := New(0, 2, 4, 5, 7, 9, 11)
+
+	assert.Equal(t, New(1, 3, 5, 6, 8, 10, 11), pentatonic.Complement())
+	assert.Equal(t, New(), New(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11).Complement())
+	assert.True(t, pentatonic.IsSubsetOf(diatonic))
+	assert.False(t, diatonic.IsSubsetOf(pentatonic))
+	assert.True(t, diatonic.IsSupersetOf(pentatonic))
+	assert.True(t, New().IsSubsetOf(pentatonic))
+}
+
+func TestSet_NormalAndPrimeForm(t *testing.T) {
+	testCases := []struct {
+		set    Set
+		normal []PitchClass
+		prime  Set
+	}{
+		{set: New(), normal: []PitchClass{}, prime: New()},
+		{set: New(4), normal: []PitchClass{4}, prime: New(0)},
+		{set: New(0, 4, 7), normal: []PitchClass{0, 4, 7}, prime: New(0, 3, 7)},
+		{set: New(2, 7, 11), normal: []PitchClass{7, 11, 2}, prime: New(0, 3, 7)},
+		{set: New(0, 3, 7), normal: []PitchClass{0, 3, 7}, prime: New(0, 3, 7)},
+		{set: New(0, 4, 8), normal: []PitchClass{0, 4, 8}, prime: New(0, 4, 8)},
+		{set: New(8, 0, 4), normal: []PitchClass{0, 4, 8}, prime: New(0, 4, 8)},
+		{set: New(7, 11, 2, 5), normal: []PitchClass{11, 2, 5, 7}, prime: New(0, 2, 5, 8)},
+		{set: New(1, 2, 6, 7, 9), normal: []PitchClass{1, 2, 6, 7, 9}, prime: New(0, 1, 5, 6, 8)},
+		{set: New(0, 2, 4, 5, 7, 9, 11), normal: []PitchClass{11, 0, 2, 4, 5, 7, 9}, prime: New(0, 1, 3, 5, 6, 8, 10)},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.normal, testCase.set.NormalForm(), testCase.set.String())
+		assert.Equal(t, testCase.prime, testCase.set.PrimeForm(), testCase.set.String())
+	}
+}
+
+func TestSet_IntervalClassVector(t *testing.T) {
+	assert.Equal(t, IntervalClassVector{0, 0, 1, 1, 1, 0}, New(0, 4, 7).IntervalClassVector())
+	assert.Equal(t, "<254361>", New(0, 2, 4, 5, 7, 9, 11).IntervalClassVector().String())
+	assert.Equal(t, "<111111>", New(0, 1, 4, 6).IntervalClassVector().String())
+	assert.Equal(t, "<000000>", New(5).IntervalClassVector().String())
+}
+
+func TestSet_ForteNumber(t *testing.T) {
+	testCases := []struct {
+		set         Set
+		forteNumber string
+	}{
+		{set: New(), forteNumber: "0-1"},
+		{set: New(3), forteNumber: "1-1"},
+		{set: New(0, 6), forteNumber: "2-6"},
+		{set: New(0, 4, 7), forteNumber: "3-11"},
+		{set: New(0, 4, 8), forteNumber: "3-12"},
+		{set: New(0, 1, 4, 6), forteNumber: "4-Z15"},
+		{set: New(0, 1, 3, 7), forteNumber: "4-Z29"},
+		{set: New(0, 2, 4, 7, 9), forteNumber: "5-35"},
+		{set: New(0, 2, 4, 6, 8, 10), forteNumber: "6-35"},
+		{set: New(0, 2, 4, 5, 7, 9, 11), forteNumber: "7-35"},
+		{set: New(0, 1, 3, 4, 6, 7, 9, 10), forteNumber: "8-28"},
+		{set: New(0, 1, 2, 4, 5, 6, 8, 9, 10), forteNumber: "9-12"},
+		{set: New(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11), forteNumber: "12-1"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.forteNumber, testCase.set.ForteNumber().String(), testCase.set.String())
+
+		prime, err := FromForteNumber(testCase.forteNumber)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.set.PrimeForm(), prime)
+	}
+
+	t.Run("all the set classes are in the list", func(t *testing.T) {
+		primeForms := getPrimeForms()
+		assert.Len(t, primeForms, 224)
+
+		for s := range fullSetMask + 1 {
+			fn := s.ForteNumber()
+			assert.Equal(t, s.Cardinality(), int(fn.Cardinality), s.String())
+			assert.Equal(t, s.PrimeForm(), primeForms[fn], s.String())
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		fn, err := ParseForteNumber("4-15")
+		require.NoError(t, err)
+		assert.Equal(t, ForteNumber{Cardinality: 4, Ordinal: 15, Z: true}, fn)
+
+		fn, err = ParseForteNumber(" 6-z29 ")
+		require.NoError(t, err)
+		assert.Equal(t, "6-Z29", fn.String())
+
+		for _, s := range []string{"", "4", "4-30", "13-1", "a-1", "4-Zb"} {
+			_, err = ParseForteNumber(s)
+			require.ErrorIs(t, err, ErrForteNumberUnknown, s)
+		}
+	})
+}
+
+func TestSet_ZRelation(t *testing.T) {
+	allInterval := New(0, 1, 4, 6)
+
+	assert.True(t, allInterval.IsZRelated(New(0, 1, 3, 7)))
+	assert.False(t, allInterval.IsZRelated(allInterval.Transpose(5)))
+	assert.False(t, allInterval.IsZRelated(New(0, 4, 7, 10)))
+
+	partner, ok := allInterval.ZPartner()
+	assert.True(t, ok)
+	assert.Equal(t, New(0, 1, 3, 7), partner)
+
+	partner, ok = New(0, 1, 2, 3, 5, 6).ZPartner()
+	assert.True(t, ok)
+	assert.Equal(t, "6-Z36", partner.ForteNumber().String())
+
+	_, ok = New(0, 4, 7).ZPartner()
+	assert.False(t, ok)
+}
@@ -0,0 +1,30 @@
+package scale
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+)
+
+// Transpose returns a new scale with the notes moved by the interval in the given direction.
+// The spelling of the notes is kept.
+func (s Scale) Transpose(ic *interval.Chromatic, direction interval.Direction) (Scale, error) {
+	notes, err := interval.TransposeNotes(note.Notes(s), ic, direction)
+	if err != nil {
+		return nil, fmt.Errorf("transpose scale: %w", err)
+	}
+
+	return Scale(notes), nil
+}
+
+// TransposeToKey returns a new scale moved from the key with one tonic to the key with another one
+// with simplified spelling of the notes.
+func (s Scale) TransposeToKey(fromTonic, toTonic *note.Note) (Scale, error) {
+	notes, err := interval.TransposeNotesToKey(note.Notes(s), fromTonic, toTonic)
+	if err != nil {
+		return nil, fmt.Errorf("transpose scale to key: %w", err)
+	}
+
+	return Scale(notes), nil
+}
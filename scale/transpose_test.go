@@ -0,0 +1,30 @@
+package scale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+)
+
+func TestScale_Transpose(t *testing.T) {
+	s := MustNewScaleFromNoteNames(note.D, note.E, note.FSHARP, note.G, note.A, note.B, note.CSHARP)
+
+	transposed, err := s.Transpose(interval.MinorSecond(), interval.DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, MustNewScaleFromNoteNames(note.EFLAT, note.F, note.G, note.AFLAT, note.BFLAT, note.C, note.D), transposed)
+
+	transposed, err = s.Transpose(interval.AugmentedUnison(), interval.DirectionAscending)
+	require.NoError(t, err)
+	assert.Equal(t, MustNewScaleFromNoteNames(note.DSHARP, note.ESHARP, note.FSHARP2, note.GSHARP, note.ASHARP, note.BSHARP, note.CSHARP2), transposed)
+
+	transposed, err = s.TransposeToKey(note.MustNewNote(note.D), note.MustNewNote(note.DSHARP))
+	require.NoError(t, err)
+	assert.Equal(t, MustNewScaleFromNoteNames(note.DSHARP, note.F, note.G, note.GSHARP, note.ASHARP, note.C, note.D), transposed)
+
+	_, err = s.Transpose(nil, interval.DirectionAscending)
+	require.ErrorIs(t, err, interval.ErrIntervalUnknown)
+}
@@ -0,0 +1,53 @@
+package track
+
+import (
+	"fmt"
+
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+)
+
+// Transpose returns a new track with the same settings and the notes of the events moved by the interval in the given direction.
+// Start times of the events are kept.
+func (t *Track) Transpose(ic *interval.Chromatic, direction interval.Direction) (*Track, error) {
+	return t.transpose(func(n *note.Note) (*note.Note, error) {
+		return interval.TransposeNote(n, ic, direction)
+	})
+}
+
+// TransposeToKey returns a new track with the same settings and the notes of the events moved
+// from the key with one tonic to the key with another one with simplified spelling.
+func (t *Track) TransposeToKey(fromTonic, toTonic *note.Note) (*Track, error) {
+	ic, err := interval.ToKey(fromTonic, toTonic)
+	if err != nil {
+		return nil, fmt.Errorf("get interval from key '%s' to key '%s': %w", fromTonic.Name(), toTonic.Name(), err)
+	}
+
+	return t.transpose(func(n *note.Note) (*note.Note, error) {
+		transposed, err := interval.TransposeNote(n, ic, interval.DirectionAscending)
+		if err != nil {
+			return nil, err
+		}
+
+		return transposed.Simplify(), nil
+	})
+}
+
+// transpose returns a new track with the same settings and the notes of the events changed by the given function.
+func (t *Track) transpose(transposeNote func(*note.Note) (*note.Note, error)) (*Track, error) {
+	if t == nil {
+		return nil, nil //nolint:nilnil // nil track is transposed to nil track
+	}
+
+	transposed := NewTrack(t.Settings)
+	for _, event := range t.events {
+		n, err := transposeNote(event.note)
+		if err != nil {
+			return nil, fmt.Errorf("transpose event with start time %v: %w", event.startTime, err)
+		}
+
+		transposed.AddEvent(NewEvent(n, event.startTime, event.isAbsolute))
+	}
+
+	return transposed, nil
+}
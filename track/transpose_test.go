@@ -0,0 +1,46 @@
+package track
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/common/fraction"
+	"github.com/go-muse/muse/interval"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestTrack_Transpose(t *testing.T) {
+	trackSettings := &Settings{
+		BPM:           uint64(120),
+		Unit:          *fraction.New(1, 4),
+		TimeSignature: *fraction.New(4, 4),
+	}
+
+	track := NewTrack(trackSettings).
+		AddNote(note.MustNewNoteWithOctave(note.B, octave.Number3).SetDuration(time.Second), 0, true).
+		AddNote(note.MustNewNoteWithOctave(note.D, octave.Number4).SetDuration(time.Second), time.Second, true)
+
+	transposed, err := track.Transpose(interval.MinorThird(), interval.DirectionAscending)
+	require.NoError(t, err)
+	require.Len(t, transposed.Events(), 2)
+	assert.Equal(t, trackSettings, transposed.Settings)
+	assert.Equal(t, NewEvent(note.MustNewNoteWithOctave(note.D, octave.Number4).SetDuration(time.Second), 0, true), transposed.Events()[0])
+	assert.Equal(t, NewEvent(note.MustNewNoteWithOctave(note.F, octave.Number4).SetDuration(time.Second), time.Second, true), transposed.Events()[1])
+	assert.Equal(t, note.B, track.Events()[0].Note().Name())
+
+	transposed, err = track.TransposeToKey(note.MustNewNote(note.G), note.MustNewNote(note.E))
+	require.NoError(t, err)
+	assert.Equal(t, note.GSHARP, transposed.Events()[0].Note().Name())
+	assert.Equal(t, octave.Number3, transposed.Events()[0].Note().Octave().Number())
+	assert.Equal(t, note.B, transposed.Events()[1].Note().Name())
+
+	_, err = track.Transpose(nil, interval.DirectionAscending)
+	require.ErrorIs(t, err, interval.ErrIntervalUnknown)
+
+	_, err = track.TransposeToKey(nil, note.MustNewNote(note.E))
+	require.ErrorIs(t, err, interval.ErrNoteEmpty)
+}
@@ -0,0 +1,212 @@
+package tuning
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Unmapped marks a key of the keyboard mapping that has no scale degree.
+const Unmapped = -1
+
+// Standard keyboard mapping values: the octave starts at C4 and the frequency is given for A4.
+const (
+	MIDINumberC4 = uint8(60)
+	MIDINumberA4 = uint8(69)
+
+	maxMIDINumber = uint8(127)
+)
+
+// KBM is a keyboard mapping in the Scala (.kbm) format.
+// It determines which MIDI keys are retuned and which scale degree each key plays.
+type KBM struct {
+	// Size is the size of the repeating mapping pattern. Zero means linear mapping of keys to degrees.
+	Size int
+	// FirstNote and LastNote are the bounds of the retuned MIDI keys.
+	FirstNote, LastNote uint8
+	// MiddleNote is the MIDI key on which the first degree of the scale is played.
+	MiddleNote uint8
+	// ReferenceNote is the MIDI key with the known frequency.
+	ReferenceNote uint8
+	// ReferenceFrequency is the frequency of the reference note in Hz.
+	ReferenceFrequency float64
+	// OctaveDegree is the scale degree that is considered as the formal octave of the mapping pattern.
+	// Zero means the period of the scale.
+	OctaveDegree int
+	// Mapping contains scale degrees for each key of the pattern. Unmapped keys are marked as Unmapped.
+	Mapping []int
+}
+
+// NewKBMStandard creates a linear keyboard mapping for the whole MIDI range
+// with the first degree of the scale on C4 and the given frequency of A4.
+func NewKBMStandard(referenceFrequency float64) *KBM {
+	return &KBM{
+		Size:               0,
+		FirstNote:          0,
+		LastNote:           maxMIDINumber,
+		MiddleNote:         MIDINumberC4,
+		ReferenceNote:      MIDINumberA4,
+		ReferenceFrequency: referenceFrequency,
+		OctaveDegree:       0,
+		Mapping:            nil,
+	}
+}
+
+// ErrInvalidKBM is returned when the keyboard mapping can't be parsed or is inconsistent.
+var ErrInvalidKBM = errors.New("invalid kbm")
+
+// ParseKBM reads a keyboard mapping in the Scala (.kbm) format.
+func ParseKBM(r io.Reader) (*KBM, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, fmt.Errorf("read kbm: %w", err)
+	}
+
+	const headerLength = 7
+	if len(lines) < headerLength {
+		return nil, fmt.Errorf("expected %d header values, got %d: %w", headerLength, len(lines), ErrInvalidKBM)
+	}
+
+	header := make([]string, headerLength)
+	for i := range header {
+		header[i] = firstField(lines[i])
+	}
+
+	kbm := &KBM{}
+	if kbm.Size, err = strconv.Atoi(header[0]); err != nil {
+		return nil, fmt.Errorf("parse map size '%s': %w", header[0], ErrInvalidKBM)
+	}
+
+	midiNumbers := []*uint8{&kbm.FirstNote, &kbm.LastNote, &kbm.MiddleNote, &kbm.ReferenceNote}
+	for i, midiNumber := range midiNumbers {
+		if *midiNumber, err = parseMIDINumber(header[i+1]); err != nil {
+			return nil, fmt.Errorf("parse header line %d: %w", i+2, err) //nolint:mnd
+		}
+	}
+
+	const referenceFrequencyLine, octaveDegreeLine = 5, 6
+	if kbm.ReferenceFrequency, err = strconv.ParseFloat(header[referenceFrequencyLine], 64); err != nil {
+		return nil, fmt.Errorf("parse reference frequency '%s': %w", header[referenceFrequencyLine], ErrInvalidKBM)
+	}
+
+	if kbm.OctaveDegree, err = strconv.Atoi(header[octaveDegreeLine]); err != nil {
+		return nil, fmt.Errorf("parse formal octave degree '%s': %w", header[octaveDegreeLine], ErrInvalidKBM)
+	}
+
+	if kbm.Size < 0 {
+		return nil, fmt.Errorf("negative map size '%d': %w", kbm.Size, ErrInvalidKBM)
+	}
+
+	// Scala allows fewer mapping entries than the size of the map, the rest keys are unmapped
+	kbm.Mapping = make([]int, kbm.Size)
+	for i := range kbm.Mapping {
+		kbm.Mapping[i] = Unmapped
+	}
+
+	for i, line := range lines[headerLength:] {
+		if i >= kbm.Size {
+			break
+		}
+
+		value := firstField(line)
+		if value == "" || strings.EqualFold(value, "x") {
+			continue
+		}
+
+		degree, err := strconv.Atoi(value)
+		if err != nil || degree < 0 {
+			return nil, fmt.Errorf("parse mapping entry '%s': %w", line, ErrInvalidKBM)
+		}
+
+		kbm.Mapping[i] = degree
+	}
+
+	if err := kbm.Validate(); err != nil {
+		return nil, err
+	}
+
+	return kbm, nil
+}
+
+// Validate checks consistency of the keyboard mapping.
+func (k *KBM) Validate() error {
+	if k == nil {
+		return fmt.Errorf("empty kbm: %w", ErrInvalidKBM)
+	}
+
+	if k.Size < 0 || len(k.Mapping) != k.Size {
+		return fmt.Errorf("map size '%d' doesn't match amount of entries '%d': %w", k.Size, len(k.Mapping), ErrInvalidKBM)
+	}
+
+	if k.FirstNote > k.LastNote || k.LastNote > maxMIDINumber {
+		return fmt.Errorf("invalid range of keys [%d; %d]: %w", k.FirstNote, k.LastNote, ErrInvalidKBM)
+	}
+
+	if k.MiddleNote > maxMIDINumber || k.ReferenceNote > maxMIDINumber {
+		return fmt.Errorf("invalid middle note '%d' or reference note '%d': %w", k.MiddleNote, k.ReferenceNote, ErrInvalidKBM)
+	}
+
+	if k.ReferenceFrequency <= 0 {
+		return fmt.Errorf("reference frequency must be positive, got '%f': %w", k.ReferenceFrequency, ErrInvalidKBM)
+	}
+
+	if k.OctaveDegree < 0 {
+		return fmt.Errorf("negative formal octave degree '%d': %w", k.OctaveDegree, ErrInvalidKBM)
+	}
+
+	return nil
+}
+
+// String returns the keyboard mapping in the Scala (.kbm) format.
+func (k *KBM) String() string {
+	if k == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("! Size of map\n%d\n", k.Size))
+	sb.WriteString(fmt.Sprintf("! First MIDI note number to retune\n%d\n", k.FirstNote))
+	sb.WriteString(fmt.Sprintf("! Last MIDI note number to retune\n%d\n", k.LastNote))
+	sb.WriteString(fmt.Sprintf("! Middle note where the first entry of the mapping is mapped to\n%d\n", k.MiddleNote))
+	sb.WriteString(fmt.Sprintf("! Reference note for which frequency is given\n%d\n", k.ReferenceNote))
+	sb.WriteString(fmt.Sprintf("! Frequency to tune the above note to\n%s\n", strconv.FormatFloat(k.ReferenceFrequency, 'f', 6, 64)))
+	sb.WriteString(fmt.Sprintf("! Scale degree to consider as formal octave\n%d\n", k.OctaveDegree))
+	sb.WriteString("! Mapping\n")
+	for _, degree := range k.Mapping {
+		if degree == Unmapped {
+			sb.WriteString("x\n")
+
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("%d\n", degree))
+	}
+
+	return sb.String()
+}
+
+// WriteTo writes the keyboard mapping in the Scala (.kbm) format.
+func (k *KBM) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, k.String())
+	if err != nil {
+		return int64(n), fmt.Errorf("write kbm: %w", err)
+	}
+
+	return int64(n), nil
+}
+
+// parseMIDINumber parses MIDI number in range [0; 127].
+func parseMIDINumber(s string) (uint8, error) {
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("parse midi number '%s': %w", s, ErrInvalidKBM)
+	}
+
+	if n > uint64(maxMIDINumber) {
+		return 0, fmt.Errorf("midi number '%d' is out of range: %w", n, ErrInvalidKBM)
+	}
+
+	return uint8(n), nil
+}
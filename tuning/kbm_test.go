@@ -0,0 +1,87 @@
+package tuning
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const kbmWhiteKeys = `! white keys only
+! Size of map
+7
+! First MIDI note number to retune
+0
+! Last MIDI note number to retune
+127
+! Middle note
+60
+! Reference note
+69
+! Reference frequency
+440.0
+! Formal octave degree
+7
+! Mapping
+0
+x
+1
+2
+3
+`
+
+func TestParseKBM(t *testing.T) {
+	t.Run("ParseKBM: mapping with unmapped keys", func(t *testing.T) {
+		kbm, err := ParseKBM(strings.NewReader(kbmWhiteKeys))
+		require.NoError(t, err)
+
+		assert.Equal(t, 7, kbm.Size)
+		assert.Equal(t, uint8(0), kbm.FirstNote)
+		assert.Equal(t, uint8(127), kbm.LastNote)
+		assert.Equal(t, MIDINumberC4, kbm.MiddleNote)
+		assert.Equal(t, MIDINumberA4, kbm.ReferenceNote)
+		assert.InDelta(t, 440.0, kbm.ReferenceFrequency, 0.000001)
+		assert.Equal(t, 7, kbm.OctaveDegree)
+		assert.Equal(t, []int{0, Unmapped, 1, 2, 3, Unmapped, Unmapped}, kbm.Mapping)
+	})
+
+	t.Run("ParseKBM: invalid files", func(t *testing.T) {
+		testCases := []string{
+			"",
+			"7\n0\n127\n60\n69\n440.0\n",
+			"-1\n0\n127\n60\n69\n440.0\n12\n",
+			"0\n0\n128\n60\n69\n440.0\n12\n",
+			"0\n100\n10\n60\n69\n440.0\n12\n",
+			"0\n0\n127\n60\n69\n0\n12\n",
+			"0\n0\n127\n60\n69\nfreq\n12\n",
+			"1\n0\n127\n60\n69\n440.0\n12\n-1\n",
+		}
+
+		for _, testCase := range testCases {
+			_, err := ParseKBM(strings.NewReader(testCase))
+			require.ErrorIs(t, err, ErrInvalidKBM, "kbm: %q", testCase)
+		}
+	})
+}
+
+func TestKBMString(t *testing.T) {
+	kbm, err := ParseKBM(strings.NewReader(kbmWhiteKeys))
+	require.NoError(t, err)
+
+	parsed, err := ParseKBM(strings.NewReader(kbm.String()))
+	require.NoError(t, err)
+	assert.Equal(t, kbm, parsed)
+
+	var sb strings.Builder
+	_, err = kbm.WriteTo(&sb)
+	require.NoError(t, err)
+	assert.Equal(t, kbm.String(), sb.String())
+}
+
+func TestNewKBMStandard(t *testing.T) {
+	kbm := NewKBMStandard(432)
+	require.NoError(t, kbm.Validate())
+	assert.Zero(t, kbm.Size)
+	assert.InDelta(t, 432.0, kbm.ReferenceFrequency, 0.000001)
+}
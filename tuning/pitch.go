@@ -0,0 +1,97 @@
+package tuning
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-muse/muse/common/fraction"
+)
+
+// CentsInOctave is amount of cents in octave.
+const CentsInOctave = 1200.0
+
+// Pitch is a single pitch of a Scala scale. It is given either in cents or as a frequency ratio.
+type Pitch struct {
+	cents float64
+	ratio *fraction.Fraction
+}
+
+// NewPitchFromCents creates a pitch given in cents.
+func NewPitchFromCents(cents float64) Pitch {
+	return Pitch{cents: cents}
+}
+
+// ErrInvalidPitch is returned when a pitch can't be parsed or has an invalid value.
+var ErrInvalidPitch = errors.New("invalid pitch")
+
+// NewPitchFromRatio creates a pitch given as a frequency ratio.
+func NewPitchFromRatio(numerator, denominator uint64) (Pitch, error) {
+	if numerator == 0 || denominator == 0 {
+		return Pitch{}, fmt.Errorf("ratio '%d/%d' must be positive: %w", numerator, denominator, ErrInvalidPitch)
+	}
+
+	return Pitch{
+		cents: CentsInOctave * math.Log2(float64(numerator)/float64(denominator)),
+		ratio: fraction.New(numerator, denominator),
+	}, nil
+}
+
+// Cents returns the size of the pitch in cents above the first degree of the scale.
+func (p Pitch) Cents() float64 {
+	return p.cents
+}
+
+// Ratio returns the frequency ratio of the pitch. It returns nil if the pitch was given in cents.
+func (p Pitch) Ratio() *fraction.Fraction {
+	return p.ratio
+}
+
+// String returns the pitch as it is written in a Scala file: ratios as "a/b", cents always with a period.
+func (p Pitch) String() string {
+	if p.ratio != nil {
+		return fmt.Sprintf("%d/%d", p.ratio.Numerator, p.ratio.Denominator)
+	}
+
+	return strconv.FormatFloat(p.cents, 'f', 5, 64)
+}
+
+// parsePitch parses a pitch line of a Scala file. Everything after the first field is a comment.
+func parsePitch(line string) (Pitch, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return Pitch{}, fmt.Errorf("empty pitch line: %w", ErrInvalidPitch)
+	}
+
+	value := fields[0]
+
+	// A value with a period is a value in cents
+	if strings.Contains(value, ".") {
+		cents, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Pitch{}, fmt.Errorf("parse cents '%s': %w", value, ErrInvalidPitch)
+		}
+
+		return NewPitchFromCents(cents), nil
+	}
+
+	// Otherwise it is a ratio, and a single integer means a ratio with denominator 1
+	numerator, denominator, found := strings.Cut(value, "/")
+	if !found {
+		denominator = "1"
+	}
+
+	num, err := strconv.ParseUint(numerator, 10, 64)
+	if err != nil {
+		return Pitch{}, fmt.Errorf("parse ratio numerator '%s': %w", value, ErrInvalidPitch)
+	}
+
+	den, err := strconv.ParseUint(denominator, 10, 64)
+	if err != nil {
+		return Pitch{}, fmt.Errorf("parse ratio denominator '%s': %w", value, ErrInvalidPitch)
+	}
+
+	return NewPitchFromRatio(num, den)
+}
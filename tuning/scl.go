@@ -0,0 +1,221 @@
+package tuning
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-muse/muse/halftone"
+	"github.com/go-muse/muse/mode"
+)
+
+// centsInHalfTone is amount of cents in a halftone of the twelve-tone equal temperament.
+const centsInHalfTone = CentsInOctave / float64(halftone.HalfTonesInOctave)
+
+// SCL is a scale in the Scala (.scl) format.
+// The first degree of the scale (1/1) is implicit, the last pitch is the period of the scale (usually the octave).
+type SCL struct {
+	Description string
+	Pitches     []Pitch
+}
+
+// ErrInvalidSCL is returned when the Scala scale can't be parsed or is inconsistent.
+var ErrInvalidSCL = errors.New("invalid scl")
+
+// ParseSCL reads a scale in the Scala (.scl) format.
+func ParseSCL(r io.Reader) (*SCL, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, fmt.Errorf("read scl: %w", err)
+	}
+
+	const headerLength = 2 // description and amount of notes
+	if len(lines) < headerLength {
+		return nil, fmt.Errorf("description or amount of notes is absent: %w", ErrInvalidSCL)
+	}
+
+	amount, err := strconv.ParseUint(firstField(lines[1]), 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("parse amount of notes '%s': %w", lines[1], ErrInvalidSCL)
+	}
+
+	pitchLines := lines[headerLength:]
+	if uint64(len(pitchLines)) < amount {
+		return nil, fmt.Errorf("expected %d pitches, got %d: %w", amount, len(pitchLines), ErrInvalidSCL)
+	}
+
+	scl := &SCL{
+		Description: strings.TrimSpace(lines[0]),
+		Pitches:     make([]Pitch, 0, amount),
+	}
+
+	for _, line := range pitchLines[:amount] {
+		pitch, err := parsePitch(line)
+		if err != nil {
+			return nil, fmt.Errorf("parse pitch '%s': %w: %w", line, ErrInvalidSCL, err)
+		}
+
+		scl.Pitches = append(scl.Pitches, pitch)
+	}
+
+	return scl, nil
+}
+
+// NewSCLFromTemplate creates a Scala scale from the mode template in the twelve-tone equal temperament.
+func NewSCLFromTemplate(modeTemplate mode.Template, description string) (*SCL, error) {
+	if err := modeTemplate.Validate(); err != nil {
+		return nil, fmt.Errorf("validate mode template to create scl: %w", err)
+	}
+
+	scl := &SCL{
+		Description: description,
+		Pitches:     make([]Pitch, 0, modeTemplate.Length()),
+	}
+
+	for iteratorResult := range modeTemplate.IterateOneRound(true) {
+		_, _, halfTonesFromPrime := iteratorResult()
+		scl.Pitches = append(scl.Pitches, NewPitchFromCents(float64(halfTonesFromPrime)*centsInHalfTone))
+	}
+
+	return scl, nil
+}
+
+// NewSCLEqualTemperament creates a Scala scale dividing the octave into the given amount of equal steps.
+func NewSCLEqualTemperament(divisions uint8) (*SCL, error) {
+	if divisions == 0 {
+		return nil, fmt.Errorf("zero divisions of octave: %w", ErrInvalidSCL)
+	}
+
+	scl := &SCL{
+		Description: fmt.Sprintf("%d-tone equal temperament", divisions),
+		Pitches:     make([]Pitch, 0, divisions),
+	}
+
+	for i := uint8(1); i < divisions; i++ {
+		scl.Pitches = append(scl.Pitches, NewPitchFromCents(CentsInOctave*float64(i)/float64(divisions)))
+	}
+
+	// The octave is written as a ratio to be exact
+	octave, _ := NewPitchFromRatio(2, 1) //nolint:mnd
+
+	scl.Pitches = append(scl.Pitches, octave)
+
+	return scl, nil
+}
+
+// Validate checks that the scale has at least one pitch.
+func (s *SCL) Validate() error {
+	if s == nil || len(s.Pitches) == 0 {
+		return fmt.Errorf("no pitches: %w", ErrInvalidSCL)
+	}
+
+	return nil
+}
+
+// Length returns amount of notes in the scale including the period and excluding the implicit 1/1.
+func (s *SCL) Length() int {
+	if s == nil {
+		return 0
+	}
+
+	return len(s.Pitches)
+}
+
+// Period returns the interval of repetition of the scale in cents.
+func (s *SCL) Period() float64 {
+	if s.Length() == 0 {
+		return 0
+	}
+
+	return s.Pitches[len(s.Pitches)-1].Cents()
+}
+
+// DegreeCents returns the distance in cents from the first degree of the scale to the given degree.
+// Degrees out of the range [0; Length()] are repeated by the period of the scale.
+func (s *SCL) DegreeCents(degree int) float64 {
+	length := s.Length()
+	if length == 0 {
+		return 0
+	}
+
+	periods := floorDiv(degree, length)
+	index := degree - periods*length
+
+	var cents float64
+	if index > 0 {
+		cents = s.Pitches[index-1].Cents()
+	}
+
+	return float64(periods)*s.Period() + cents
+}
+
+// String returns the scale in the Scala (.scl) format.
+func (s *SCL) String() string {
+	if s == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("!\n")
+	sb.WriteString(s.Description + "\n")
+	sb.WriteString(fmt.Sprintf(" %d\n", len(s.Pitches)))
+	sb.WriteString("!\n")
+	for _, pitch := range s.Pitches {
+		sb.WriteString(" " + pitch.String() + "\n")
+	}
+
+	return sb.String()
+}
+
+// WriteTo writes the scale in the Scala (.scl) format.
+func (s *SCL) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, s.String())
+	if err != nil {
+		return int64(n), fmt.Errorf("write scl: %w", err)
+	}
+
+	return int64(n), nil
+}
+
+// readLines returns all the lines of Scala file except comments.
+func readLines(r io.Reader) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// firstField returns the first whitespace separated field of the line.
+func firstField(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
+
+// floorDiv returns the quotient rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
@@ -0,0 +1,135 @@
+package tuning
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/mode"
+)
+
+const sclJustIntonation = `! ji_12.scl
+!
+5-limit just intonation
+ 12
+!
+ 16/15
+ 9/8
+ 6/5
+ 5/4
+ 4/3
+ 45/32   tritone
+ 3/2
+ 8/5
+ 5/3
+ 9/5
+ 15/8
+ 2
+`
+
+func TestParseSCL(t *testing.T) {
+	t.Run("ParseSCL: ratios", func(t *testing.T) {
+		scl, err := ParseSCL(strings.NewReader(sclJustIntonation))
+		require.NoError(t, err)
+
+		assert.Equal(t, "5-limit just intonation", scl.Description)
+		assert.Equal(t, 12, scl.Length())
+		assert.Equal(t, "45/32", scl.Pitches[5].String())
+		assert.Equal(t, "2/1", scl.Pitches[11].String())
+		assert.InDelta(t, 701.955, scl.Pitches[6].Cents(), 0.001)
+		assert.InDelta(t, CentsInOctave, scl.Period(), 0.000001)
+	})
+
+	t.Run("ParseSCL: cents and empty description", func(t *testing.T) {
+		scl, err := ParseSCL(strings.NewReader("!\n\n3\n400.0\n700.\n1200.000 octave\n"))
+		require.NoError(t, err)
+
+		assert.Empty(t, scl.Description)
+		assert.Equal(t, 3, scl.Length())
+		assert.InDelta(t, 700.0, scl.Pitches[1].Cents(), 0.000001)
+		assert.Nil(t, scl.Pitches[1].Ratio())
+	})
+
+	t.Run("ParseSCL: invalid files", func(t *testing.T) {
+		testCases := []string{
+			"",
+			"description\n",
+			"description\nseven\n",
+			"description\n3\n100.0\n200.0\n",
+			"description\n1\n3/0\n",
+			"description\n1\n-3/2\n",
+			"description\n1\ncents\n",
+		}
+
+		for _, testCase := range testCases {
+			_, err := ParseSCL(strings.NewReader(testCase))
+			require.ErrorIs(t, err, ErrInvalidSCL, "scl: %q", testCase)
+		}
+	})
+}
+
+func TestSCLString(t *testing.T) {
+	scl, err := ParseSCL(strings.NewReader(sclJustIntonation))
+	require.NoError(t, err)
+
+	parsed, err := ParseSCL(strings.NewReader(scl.String()))
+	require.NoError(t, err)
+	assert.Equal(t, scl, parsed)
+
+	var sb strings.Builder
+	n, err := scl.WriteTo(&sb)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(scl.String())), n)
+	assert.Equal(t, scl.String(), sb.String())
+}
+
+func TestSCLDegreeCents(t *testing.T) {
+	scl, err := NewSCLEqualTemperament(12)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		degree   int
+		expected float64
+	}{
+		{degree: 0, expected: 0},
+		{degree: 1, expected: 100},
+		{degree: 12, expected: 1200},
+		{degree: 13, expected: 1300},
+		{degree: -1, expected: -100},
+		{degree: -12, expected: -1200},
+		{degree: -13, expected: -1300},
+	}
+
+	for _, testCase := range testCases {
+		assert.InDelta(t, testCase.expected, scl.DegreeCents(testCase.degree), 0.000001, "degree: %d", testCase.degree)
+	}
+
+	assert.Zero(t, (&SCL{}).DegreeCents(3))
+}
+
+func TestNewSCLFromTemplate(t *testing.T) {
+	scl, err := NewSCLFromTemplate(mode.TemplateDorian(), "Dorian")
+	require.NoError(t, err)
+
+	expected := []float64{200, 300, 500, 700, 900, 1000, 1200}
+	require.Equal(t, len(expected), scl.Length())
+	for i, pitch := range scl.Pitches {
+		assert.InDelta(t, expected[i], pitch.Cents(), 0.000001)
+	}
+
+	_, err = NewSCLFromTemplate(mode.Template{1, 2}, "invalid")
+	require.ErrorIs(t, err, mode.ErrInvalidModeTemplate)
+}
+
+func TestNewSCLEqualTemperament(t *testing.T) {
+	scl, err := NewSCLEqualTemperament(24)
+	require.NoError(t, err)
+	assert.Equal(t, 24, scl.Length())
+	assert.InDelta(t, 50.0, scl.Pitches[0].Cents(), 0.000001)
+	assert.Equal(t, "2/1", scl.Pitches[23].String())
+
+	_, err = NewSCLEqualTemperament(0)
+	require.ErrorIs(t, err, ErrInvalidSCL)
+}
@@ -0,0 +1,119 @@
+package tuning
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/go-muse/muse/note"
+)
+
+// Tuning is a combination of a Scala scale and a keyboard mapping.
+// It gives the frequency for each MIDI number and note.
+type Tuning struct {
+	scl *SCL
+	kbm *KBM
+}
+
+// ErrKeyOutOfRange is returned when the MIDI key is not retuned by the keyboard mapping.
+var ErrKeyOutOfRange = errors.New("key is out of range of the keyboard mapping")
+
+// ErrKeyUnmapped is returned when the MIDI key has no scale degree in the keyboard mapping.
+var ErrKeyUnmapped = errors.New("key is unmapped")
+
+// New creates a tuning from the scale and the keyboard mapping.
+// If the keyboard mapping is nil, the standard mapping with A4 = 440 Hz is used.
+func New(scl *SCL, kbm *KBM) (*Tuning, error) {
+	if err := scl.Validate(); err != nil {
+		return nil, fmt.Errorf("validate scl to create tuning: %w", err)
+	}
+
+	if kbm == nil {
+		kbm = NewKBMStandard(note.FreqA440)
+	}
+
+	if err := kbm.Validate(); err != nil {
+		return nil, fmt.Errorf("validate kbm to create tuning: %w", err)
+	}
+
+	t := &Tuning{scl: scl, kbm: kbm}
+
+	// The reference note must be mapped to calculate frequencies of the other notes
+	if _, err := t.centsFromMiddleNote(kbm.ReferenceNote); err != nil {
+		return nil, fmt.Errorf("reference note '%d': %w", kbm.ReferenceNote, err)
+	}
+
+	return t, nil
+}
+
+// SCL returns the scale of the tuning.
+func (t *Tuning) SCL() *SCL {
+	if t == nil {
+		return nil
+	}
+
+	return t.scl
+}
+
+// KBM returns the keyboard mapping of the tuning.
+func (t *Tuning) KBM() *KBM {
+	if t == nil {
+		return nil
+	}
+
+	return t.kbm
+}
+
+// FrequencyByMIDINumber returns frequency in Hz of the given MIDI key.
+func (t *Tuning) FrequencyByMIDINumber(midiNumber uint8) (float64, error) {
+	if midiNumber < t.kbm.FirstNote || midiNumber > t.kbm.LastNote {
+		return 0, fmt.Errorf("midi number '%d', range [%d; %d]: %w", midiNumber, t.kbm.FirstNote, t.kbm.LastNote, ErrKeyOutOfRange)
+	}
+
+	cents, err := t.centsFromMiddleNote(midiNumber)
+	if err != nil {
+		return 0, fmt.Errorf("midi number '%d': %w", midiNumber, err)
+	}
+
+	referenceCents, err := t.centsFromMiddleNote(t.kbm.ReferenceNote)
+	if err != nil {
+		return 0, fmt.Errorf("reference note '%d': %w", t.kbm.ReferenceNote, err)
+	}
+
+	return t.kbm.ReferenceFrequency * math.Pow(2, (cents-referenceCents)/CentsInOctave), nil
+}
+
+// ErrNoteWithoutOctave is returned when the note has no octave, so it is impossible to determine its MIDI number.
+var ErrNoteWithoutOctave = errors.New("note without octave")
+
+// Frequency returns frequency in Hz of the note played on the MIDI key of the note.
+func (t *Tuning) Frequency(n *note.Note) (float64, error) {
+	if n == nil || n.Octave() == nil {
+		return 0, ErrNoteWithoutOctave
+	}
+
+	return t.FrequencyByMIDINumber(n.MIDINumber())
+}
+
+// centsFromMiddleNote returns the distance in cents from the middle note of the mapping to the given MIDI key.
+func (t *Tuning) centsFromMiddleNote(midiNumber uint8) (float64, error) {
+	offset := int(midiNumber) - int(t.kbm.MiddleNote)
+
+	// Linear mapping: each next key plays the next degree of the scale
+	if t.kbm.Size == 0 {
+		return t.scl.DegreeCents(offset), nil
+	}
+
+	repetitions := floorDiv(offset, t.kbm.Size)
+	degree := t.kbm.Mapping[offset-repetitions*t.kbm.Size]
+	if degree == Unmapped {
+		return 0, ErrKeyUnmapped
+	}
+
+	formalOctave := t.scl.Period()
+	if t.kbm.OctaveDegree > 0 {
+		formalOctave = t.scl.DegreeCents(t.kbm.OctaveDegree)
+	}
+
+	return float64(repetitions)*formalOctave + t.scl.DegreeCents(degree), nil
+}
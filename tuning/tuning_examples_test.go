@@ -0,0 +1,54 @@
+package tuning_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+	"github.com/go-muse/muse/tuning"
+)
+
+// Export a mode template as a Scala scale to load it in a synthesizer.
+func ExampleNewSCLFromTemplate() {
+	scl, err := tuning.NewSCLFromTemplate(mode.TemplatePentatonicMajor(), "Pentatonic major")
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err = scl.WriteTo(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: !
+	// Pentatonic major
+	//  5
+	// !
+	//  200.00000
+	//  400.00000
+	//  700.00000
+	//  900.00000
+	//  1200.00000
+}
+
+// Get frequencies of notes in the quarter-tone equal temperament.
+func ExampleTuning_Frequency() {
+	scl, err := tuning.NewSCLEqualTemperament(24)
+	if err != nil {
+		panic(err)
+	}
+
+	// Each next key above middle C (60) plays the next quarter tone, the key 69 is tuned to 440 Hz
+	t, err := tuning.New(scl, tuning.NewKBMStandard(note.FreqA440))
+	if err != nil {
+		panic(err)
+	}
+
+	frequency, err := t.Frequency(note.MustNewNoteWithOctave(note.CSHARP, octave.Number4))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.2f\n", frequency)
+	// Output: 349.23
+}
@@ -0,0 +1,121 @@
+package tuning
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-muse/muse/mode"
+	"github.com/go-muse/muse/note"
+	"github.com/go-muse/muse/octave"
+)
+
+func TestTuningEqualTemperament(t *testing.T) {
+	scl, err := NewSCLEqualTemperament(12)
+	require.NoError(t, err)
+
+	tuning, err := New(scl, nil)
+	require.NoError(t, err)
+
+	// The standard mapping must give the same frequencies as the notes themselves
+	for midiNumber := uint8(0); midiNumber <= 127; midiNumber++ {
+		n, err := note.NewNoteFromMIDINumber(midiNumber)
+		require.NoError(t, err)
+
+		frequency, err := tuning.Frequency(n)
+		require.NoError(t, err)
+		assert.InEpsilon(t, n.FrequencyBy440(), frequency, 0.000001, "note: %s%d", n.Name(), n.Octave().Number())
+	}
+
+	_, err = tuning.Frequency(note.MustNewNote(note.C))
+	require.ErrorIs(t, err, ErrNoteWithoutOctave)
+}
+
+func TestTuningJustIntonation(t *testing.T) {
+	scl, err := ParseSCL(strings.NewReader(sclJustIntonation))
+	require.NoError(t, err)
+
+	kbm := NewKBMStandard(note.FreqA440)
+	kbm.ReferenceNote = MIDINumberC4
+	kbm.ReferenceFrequency = 264
+
+	tuning, err := New(scl, kbm)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		note     *note.Note
+		expected float64
+	}{
+		{note: note.MustNewNoteWithOctave(note.C, octave.Number4), expected: 264},
+		{note: note.MustNewNoteWithOctave(note.E, octave.Number4), expected: 330},
+		{note: note.MustNewNoteWithOctave(note.G, octave.Number4), expected: 396},
+		{note: note.MustNewNoteWithOctave(note.A, octave.Number4), expected: 440},
+		{note: note.MustNewNoteWithOctave(note.C, octave.Number5), expected: 528},
+		{note: note.MustNewNoteWithOctave(note.G, octave.Number3), expected: 198},
+	}
+
+	for _, testCase := range testCases {
+		frequency, err := tuning.Frequency(testCase.note)
+		require.NoError(t, err)
+		assert.InEpsilon(t, testCase.expected, frequency, 0.000001, "note: %s", testCase.note.Name())
+	}
+}
+
+func TestTuningKeyboardMapping(t *testing.T) {
+	scl, err := NewSCLFromTemplate(mode.TemplateIonian(), "Ionian")
+	require.NoError(t, err)
+
+	kbm, err := ParseKBM(strings.NewReader(kbmWhiteKeys))
+	require.NoError(t, err)
+
+	// the reference note C#4 is unmapped in this mapping
+	kbm.ReferenceNote = 61
+	_, err = New(scl, kbm)
+	require.ErrorIs(t, err, ErrKeyUnmapped)
+
+	kbm.ReferenceNote = MIDINumberC4
+	kbm.ReferenceFrequency = 261.6255653005986
+	kbm.FirstNote = 48
+	kbm.LastNote = 80
+
+	tuning, err := New(scl, kbm)
+	require.NoError(t, err)
+
+	// keys 60, 62, 63, 64 play degrees 0, 1, 2, 3 of the scale: C, D, E, F
+	testCases := map[uint8]float64{
+		60: note.MustNewNoteWithOctave(note.C, octave.Number4).FrequencyBy440(),
+		62: note.MustNewNoteWithOctave(note.D, octave.Number4).FrequencyBy440(),
+		63: note.MustNewNoteWithOctave(note.E, octave.Number4).FrequencyBy440(),
+		64: note.MustNewNoteWithOctave(note.F, octave.Number4).FrequencyBy440(),
+		67: note.MustNewNoteWithOctave(note.C, octave.Number5).FrequencyBy440(),
+		53: note.MustNewNoteWithOctave(note.C, octave.Number3).FrequencyBy440(),
+	}
+
+	for midiNumber, expected := range testCases {
+		frequency, err := tuning.FrequencyByMIDINumber(midiNumber)
+		require.NoError(t, err)
+		assert.InEpsilon(t, expected, frequency, 0.000001, "midi number: %d", midiNumber)
+	}
+
+	_, err = tuning.FrequencyByMIDINumber(61)
+	require.ErrorIs(t, err, ErrKeyUnmapped)
+
+	_, err = tuning.FrequencyByMIDINumber(81)
+	require.ErrorIs(t, err, ErrKeyOutOfRange)
+
+	assert.Equal(t, scl, tuning.SCL())
+	assert.Equal(t, kbm, tuning.KBM())
+}
+
+func TestNewTuningInvalid(t *testing.T) {
+	_, err := New(&SCL{}, nil)
+	require.ErrorIs(t, err, ErrInvalidSCL)
+
+	scl, err := NewSCLEqualTemperament(12)
+	require.NoError(t, err)
+
+	_, err = New(scl, &KBM{})
+	require.ErrorIs(t, err, ErrInvalidKBM)
+}